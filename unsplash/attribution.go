@@ -0,0 +1,90 @@
+package unsplash
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+	"strings"
+)
+
+// Attribution is a photo credit required by the Unsplash API guidelines in
+// several formats. All links have utm_source=<app>&utm_medium=referral.
+type Attribution struct {
+	// Text is "Photo by <name> on Unsplash".
+	Text     string
+	HTML     string
+	Markdown string
+
+	PhotographerName string
+	PhotographerURL  string
+	PhotoURL         string
+	UnsplashURL      string
+}
+
+// Attribution returns credits for the photo's author. It requires the app
+// name set by WithAppName.
+func (c *Client) Attribution(photo *Photo) (*Attribution, error) {
+	if c.appName == "" {
+		return nil, ErrNoAppName
+	}
+
+	if photo == nil {
+		return nil, ErrBadRequest
+	}
+
+	name := photo.User.Name
+	if name == "" {
+		name = photo.User.Username
+	}
+
+	profile := photo.User.Links.HTML
+	if profile == "" {
+		if photo.User.Username == "" {
+			return nil, ErrBadRequest
+		}
+
+		profile = siteURL + "@" + photo.User.Username
+	}
+
+	photoURL := photo.Links.HTML
+	if photoURL == "" && photo.ID != "" {
+		photoURL = siteURL + "photos/" + photo.ID
+	}
+
+	a := Attribution{
+		PhotographerName: name,
+		PhotographerURL:  c.referral(profile),
+		UnsplashURL:      c.referral(siteURL),
+	}
+
+	if photoURL != "" {
+		a.PhotoURL = c.referral(photoURL)
+	}
+
+	a.Text = fmt.Sprintf("Photo by %s on Unsplash", name)
+	a.HTML = fmt.Sprintf(`Photo by <a href="%s">%s</a> on <a href="%s">Unsplash</a>`,
+		html.EscapeString(a.PhotographerURL), html.EscapeString(name), html.EscapeString(a.UnsplashURL))
+	a.Markdown = fmt.Sprintf("Photo by [%s](%s) on [Unsplash](%s)",
+		markdownEscaper.Replace(name), a.PhotographerURL, a.UnsplashURL)
+
+	return &a, nil
+}
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, `[`, `\[`, `]`, `\]`, `*`, `\*`, `_`, `\_`, "`", "\\`", `<`, `\<`,
+)
+
+// referral adds utm parameters to link.
+func (c *Client) referral(link string) string {
+	u, err := url.Parse(link)
+	if err != nil {
+		return link
+	}
+
+	query := u.Query()
+	query.Set("utm_source", c.appName)
+	query.Set("utm_medium", "referral")
+	u.RawQuery = query.Encode()
+
+	return u.String()
+}
@@ -0,0 +1,59 @@
+package unsplash_test
+
+import (
+	"errors"
+	"github.com/kazhuravlev/go-unsplash/unsplash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestAttribution(t *testing.T) {
+	c, err := unsplash.New(unsplash.WithAppName("my app"))
+	require.Nil(t, err)
+
+	photo := unsplash.Photo{
+		ID: "abc",
+		User: unsplash.User{
+			Username: "jdoe",
+			Name:     "John <Doe>_",
+			Links:    unsplash.UserLinks{HTML: "https://unsplash.com/@jdoe"},
+		},
+	}
+
+	a, err := c.Attribution(&photo)
+	require.Nil(t, err)
+
+	assert.Equal(t, "https://unsplash.com/@jdoe?utm_medium=referral&utm_source=my+app", a.PhotographerURL)
+	assert.Equal(t, "https://unsplash.com/?utm_medium=referral&utm_source=my+app", a.UnsplashURL)
+	assert.Equal(t, "https://unsplash.com/photos/abc?utm_medium=referral&utm_source=my+app", a.PhotoURL)
+	assert.Equal(t, "Photo by John <Doe>_ on Unsplash", a.Text)
+	assert.Equal(t, `Photo by <a href="https://unsplash.com/@jdoe?utm_medium=referral&amp;utm_source=my+app">John &lt;Doe&gt;_</a>`+
+		` on <a href="https://unsplash.com/?utm_medium=referral&amp;utm_source=my+app">Unsplash</a>`, a.HTML)
+	assert.Equal(t, `Photo by [John \<Doe>\_](https://unsplash.com/@jdoe?utm_medium=referral&utm_source=my+app)`+
+		` on [Unsplash](https://unsplash.com/?utm_medium=referral&utm_source=my+app)`, a.Markdown)
+
+	// profile link is built from username when links are missing
+	a, err = c.Attribution(&unsplash.Photo{User: unsplash.User{Username: "jdoe"}})
+	require.Nil(t, err)
+	assert.Equal(t, "jdoe", a.PhotographerName)
+	assert.Equal(t, "https://unsplash.com/@jdoe?utm_medium=referral&utm_source=my+app", a.PhotographerURL)
+	assert.Equal(t, "", a.PhotoURL)
+
+	_, err = c.Attribution(&unsplash.Photo{})
+	assert.True(t, errors.Is(err, unsplash.ErrBadRequest))
+
+	_, err = c.Attribution(nil)
+	assert.True(t, errors.Is(err, unsplash.ErrBadRequest))
+}
+
+func TestAttribution_NoAppName(t *testing.T) {
+	c, err := unsplash.New()
+	require.Nil(t, err)
+
+	_, err = c.Attribution(&unsplash.Photo{User: unsplash.User{Username: "jdoe"}})
+	assert.True(t, errors.Is(err, unsplash.ErrNoAppName))
+
+	_, err = unsplash.New(unsplash.WithAppName(""))
+	assert.True(t, errors.Is(err, unsplash.ErrBadRequest))
+}
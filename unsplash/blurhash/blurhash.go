@@ -0,0 +1,175 @@
+// Package blurhash decodes BlurHash strings (https://blurha.sh) returned by
+// the API in Photo.BlurHash into small placeholder images.
+package blurhash
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+	"strings"
+)
+
+const alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz#$%*+,-.:;=?@[]^_{|}~"
+
+var (
+	ErrInvalidHash = errors.New("blurhash: invalid hash")
+	ErrInvalidSize = errors.New("blurhash: invalid size")
+)
+
+// Components returns the number of horizontal and vertical components of
+// the hash.
+func Components(hash string) (x, y int, err error) {
+	if len(hash) < 6 {
+		return 0, 0, ErrInvalidHash
+	}
+
+	size, err := decode83(hash[:1])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	x, y = size%9+1, size/9+1
+	if len(hash) != 4+2*x*y {
+		return 0, 0, ErrInvalidHash
+	}
+
+	return x, y, nil
+}
+
+// Decode decodes the hash to an image of the given size. Placeholders are
+// usually decoded to 32x32 or less and scaled by the browser. punch
+// increases the contrast; 1 is the default.
+func Decode(hash string, width, height int, punch float64) (image.Image, error) {
+	if width <= 0 || height <= 0 {
+		return nil, ErrInvalidSize
+	}
+
+	if punch <= 0 {
+		punch = 1
+	}
+
+	numX, numY, err := Components(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	quantMax, err := decode83(hash[1:2])
+	if err != nil {
+		return nil, err
+	}
+
+	maxValue := float64(quantMax+1) / 166
+
+	colors := make([][3]float64, numX*numY)
+	for i := range colors {
+		if i == 0 {
+			v, err := decode83(hash[2:6])
+			if err != nil {
+				return nil, err
+			}
+
+			colors[i] = decodeDC(v)
+			continue
+		}
+
+		v, err := decode83(hash[4+i*2 : 6+i*2])
+		if err != nil {
+			return nil, err
+		}
+
+		colors[i] = decodeAC(v, maxValue*punch)
+	}
+
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			var r, g, b float64
+			for j := 0; j < numY; j++ {
+				for i := 0; i < numX; i++ {
+					basis := math.Cos(math.Pi*float64(x*i)/float64(width)) *
+						math.Cos(math.Pi*float64(y*j)/float64(height))
+					c := colors[i+j*numX]
+					r += c[0] * basis
+					g += c[1] * basis
+					b += c[2] * basis
+				}
+			}
+
+			img.SetNRGBA(x, y, color.NRGBA{R: linearToSRGB(r), G: linearToSRGB(g), B: linearToSRGB(b), A: 0xff})
+		}
+	}
+
+	return img, nil
+}
+
+// DataURI decodes the hash to a PNG image and returns it as a data URI
+// which can be used as src of an <img> element.
+func DataURI(hash string, width, height int) (string, error) {
+	img, err := Decode(hash, width, height, 1)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return "", err
+	}
+
+	return "data:image/png;base64," + base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+func decode83(s string) (int, error) {
+	v := 0
+	for i := 0; i < len(s); i++ {
+		digit := strings.IndexByte(alphabet, s[i])
+		if digit == -1 {
+			return 0, ErrInvalidHash
+		}
+
+		v = v*83 + digit
+	}
+
+	return v, nil
+}
+
+func decodeDC(v int) [3]float64 {
+	return [3]float64{
+		sRGBToLinear(v >> 16),
+		sRGBToLinear(v >> 8 & 0xff),
+		sRGBToLinear(v & 0xff),
+	}
+}
+
+func decodeAC(v int, maxValue float64) [3]float64 {
+	return [3]float64{
+		signPow((float64(v/(19*19))-9)/9, 2) * maxValue,
+		signPow((float64(v/19%19)-9)/9, 2) * maxValue,
+		signPow((float64(v%19)-9)/9, 2) * maxValue,
+	}
+}
+
+func signPow(v, exp float64) float64 {
+	return math.Copysign(math.Pow(math.Abs(v), exp), v)
+}
+
+func sRGBToLinear(v int) float64 {
+	f := float64(v) / 255
+	if f <= 0.04045 {
+		return f / 12.92
+	}
+
+	return math.Pow((f+0.055)/1.055, 2.4)
+}
+
+func linearToSRGB(v float64) uint8 {
+	v = math.Max(0, math.Min(1, v))
+	if v <= 0.0031308 {
+		return uint8(v*12.92*255 + 0.5)
+	}
+
+	return uint8((1.055*math.Pow(v, 1/2.4)-0.055)*255 + 0.5)
+}
@@ -0,0 +1,77 @@
+package blurhash_test
+
+import (
+	"encoding/base64"
+	"errors"
+	"github.com/kazhuravlev/go-unsplash/unsplash/blurhash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+const hash = "LEHV6nWB2yk8pyo0adR*.7kCMdnj"
+
+func TestComponents(t *testing.T) {
+	x, y, err := blurhash.Components(hash)
+	require.Nil(t, err)
+	assert.Equal(t, 4, x)
+	assert.Equal(t, 3, y)
+
+	for _, h := range []string{"", "LEHV6", hash[:len(hash)-1], "\"0000"} {
+		_, _, err := blurhash.Components(h)
+		assert.True(t, errors.Is(err, blurhash.ErrInvalidHash), h)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	img, err := blurhash.Decode(hash, 32, 24, 1)
+	require.Nil(t, err)
+	assert.Equal(t, 32, img.Bounds().Dx())
+	assert.Equal(t, 24, img.Bounds().Dy())
+
+	// single component hash of #60544D decodes to a solid image
+	img, err = blurhash.Decode("00"+encode83(0x60544D, 4), 4, 4, 1)
+	require.Nil(t, err)
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 4; x++ {
+			assert.Equal(t, color.NRGBA{R: 0x60, G: 0x54, B: 0x4D, A: 0xff}, img.At(x, y))
+		}
+	}
+
+	_, err = blurhash.Decode(hash, 0, 10, 1)
+	assert.True(t, errors.Is(err, blurhash.ErrInvalidSize))
+
+	_, err = blurhash.Decode("LEHV6nWB2yk8pyo0adR*.7kCMdn\"", 10, 10, 1)
+	assert.True(t, errors.Is(err, blurhash.ErrInvalidHash))
+}
+
+func TestDataURI(t *testing.T) {
+	uri, err := blurhash.DataURI(hash, 8, 6)
+	require.Nil(t, err)
+
+	const prefix = "data:image/png;base64,"
+	require.True(t, strings.HasPrefix(uri, prefix))
+
+	data, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(uri, prefix))
+	require.Nil(t, err)
+
+	img, err := png.Decode(strings.NewReader(string(data)))
+	require.Nil(t, err)
+	assert.Equal(t, 8, img.Bounds().Dx())
+	assert.Equal(t, 6, img.Bounds().Dy())
+}
+
+func encode83(v, length int) string {
+	const alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz#$%*+,-.:;=?@[]^_{|}~"
+
+	out := make([]byte, length)
+	for i := length - 1; i >= 0; i-- {
+		out[i] = alphabet[v%83]
+		v /= 83
+	}
+
+	return string(out)
+}
@@ -0,0 +1,291 @@
+package unsplash
+
+import (
+	"bufio"
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Cache stores raw HTTP responses for conditional requests. Implementations
+// must be safe for concurrent use. A cache should not be shared between
+// clients authorized as different users.
+type Cache interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, value []byte)
+	Delete(key string)
+}
+
+// CacheStats reports how many GET requests were served from the cache
+// (including 304 Not Modified revalidations) and how many were not.
+type CacheStats struct {
+	Hits   int64
+	Misses int64
+}
+
+// maxCachedBody is the largest response body stored in the cache.
+const maxCachedBody = 1 << 20
+
+// WithCache enables HTTP caching of GET responses which carry ETag or
+// Last-Modified headers. Cached responses are revalidated with conditional
+// requests. Only JSON responses up to 1 MiB are cached.
+func WithCache(cache Cache) Option {
+	return func(c *Client) error {
+		if cache == nil {
+			return ErrBadRequest
+		}
+
+		c.cache = &httpCache{cache: cache}
+		return nil
+	}
+}
+
+// CacheStats returns cache hit/miss counters. Zero stats are returned when
+// the cache is not enabled.
+func (c *Client) CacheStats() CacheStats {
+	if c.cache == nil {
+		return CacheStats{}
+	}
+
+	return CacheStats{
+		Hits:   atomic.LoadInt64(&c.cache.hits),
+		Misses: atomic.LoadInt64(&c.cache.misses),
+	}
+}
+
+type httpCache struct {
+	cache  Cache
+	hits   int64
+	misses int64
+}
+
+// roundTrip serves GET requests through the cache, sending the rest to next.
+func (h *httpCache) roundTrip(req *http.Request, next func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		return next(req)
+	}
+
+	key := req.URL.String()
+
+	cached := h.load(key, req)
+	if cached != nil {
+		if etag := cached.Header.Get("Etag"); etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+
+		if lastModified := cached.Header.Get("Last-Modified"); lastModified != "" {
+			req.Header.Set("If-Modified-Since", lastModified)
+		}
+	}
+
+	resp, err := next(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		atomic.AddInt64(&h.hits, 1)
+
+		// headers of 304 response (like rate limits) are more recent
+		for k, v := range resp.Header {
+			cached.Header[k] = v
+		}
+		drain(resp.Body)
+
+		return cached, nil
+	}
+
+	atomic.AddInt64(&h.misses, 1)
+
+	if resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+
+	if resp.Header.Get("Etag") == "" && resp.Header.Get("Last-Modified") == "" {
+		return resp, nil
+	}
+
+	if !cacheable(resp) {
+		return resp, nil
+	}
+
+	dump, err := httputil.DumpResponse(resp, true)
+	if err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+
+	h.cache.Set(key, dump)
+
+	return resp, nil
+}
+
+// cacheable reports whether resp is a JSON response with a body small enough
+// to be cached. The body of resp is buffered when its length is unknown.
+func cacheable(resp *http.Response) bool {
+	if !strings.Contains(resp.Header.Get("Content-Type"), "json") {
+		return false
+	}
+
+	if resp.ContentLength > maxCachedBody {
+		return false
+	}
+
+	if resp.ContentLength >= 0 {
+		return true
+	}
+
+	head, err := io.ReadAll(io.LimitReader(resp.Body, maxCachedBody+1))
+	body := resp.Body
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(head), body), body}
+
+	return err == nil && len(head) <= maxCachedBody
+}
+
+func (h *httpCache) load(key string, req *http.Request) *http.Response {
+	dump, ok := h.cache.Get(key)
+	if !ok {
+		return nil
+	}
+
+	resp, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(dump)), req)
+	if err != nil {
+		h.cache.Delete(key)
+		return nil
+	}
+
+	return resp
+}
+
+type memoryCache struct {
+	maxEntries int
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+}
+
+type memoryCacheEntry struct {
+	key   string
+	value []byte
+}
+
+// NewMemoryCache returns in-memory LRU cache holding up to maxEntries
+// responses. maxEntries 0 means no limit.
+func NewMemoryCache(maxEntries int) Cache {
+	return &memoryCache{
+		maxEntries: maxEntries,
+		entries:    make(map[string]*list.Element),
+		lru:        list.New(),
+	}
+}
+
+func (m *memoryCache) Get(key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	el, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	m.lru.MoveToFront(el)
+
+	return el.Value.(*memoryCacheEntry).value, true
+}
+
+func (m *memoryCache) Set(key string, value []byte) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if el, ok := m.entries[key]; ok {
+		el.Value.(*memoryCacheEntry).value = value
+		m.lru.MoveToFront(el)
+		return
+	}
+
+	m.entries[key] = m.lru.PushFront(&memoryCacheEntry{key: key, value: value})
+
+	if m.maxEntries > 0 && m.lru.Len() > m.maxEntries {
+		oldest := m.lru.Back()
+		m.lru.Remove(oldest)
+		delete(m.entries, oldest.Value.(*memoryCacheEntry).key)
+	}
+}
+
+func (m *memoryCache) Delete(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if el, ok := m.entries[key]; ok {
+		m.lru.Remove(el)
+		delete(m.entries, key)
+	}
+}
+
+type diskCache struct {
+	dir string
+}
+
+// NewDiskCache returns cache which stores responses as files in dir. The
+// directory is created if it does not exist.
+func NewDiskCache(dir string) (Cache, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+
+	return &diskCache{dir: dir}, nil
+}
+
+func (d *diskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(d.dir, hex.EncodeToString(sum[:]))
+}
+
+func (d *diskCache) Get(key string) ([]byte, bool) {
+	value, err := os.ReadFile(d.path(key))
+	if err != nil {
+		return nil, false
+	}
+
+	return value, true
+}
+
+// Set writes value into a temporary file and renames it so readers never see
+// a partially written entry. Errors are ignored: caching is best-effort.
+func (d *diskCache) Set(key string, value []byte) {
+	f, err := os.CreateTemp(d.dir, "tmp-")
+	if err != nil {
+		return
+	}
+
+	_, err = f.Write(value)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		os.Remove(f.Name())
+		return
+	}
+
+	if err := os.Rename(f.Name(), d.path(key)); err != nil {
+		os.Remove(f.Name())
+	}
+}
+
+func (d *diskCache) Delete(key string) {
+	os.Remove(d.path(key))
+}
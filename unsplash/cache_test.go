@@ -0,0 +1,124 @@
+package unsplash_test
+
+import (
+	"context"
+	"github.com/kazhuravlev/go-unsplash/unsplash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// newETagServer serves photos with ETag and answers 304 to conditional
+// requests. Rate limit remaining decreases with every request.
+func newETagServer(requests *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		w.Header().Set("X-Ratelimit-Limit", "50")
+		w.Header().Set("X-Ratelimit-Remaining", strconv.Itoa(50-*requests))
+		w.Header().Set("Etag", `"v1"`)
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Write([]byte(`{"id":"abc"}`))
+	}))
+}
+
+func testCache(t *testing.T, cache unsplash.Cache) {
+	var requests int
+	srv := newETagServer(&requests)
+	defer srv.Close()
+
+	c, err := unsplash.New(unsplash.WithBaseURL(srv.URL), unsplash.WithCache(cache))
+	require.Nil(t, err)
+
+	for i := 1; i <= 3; i++ {
+		photo, rl, err := c.GetPhoto(context.Background(), "abc")
+		require.Nil(t, err)
+		assert.Equal(t, "abc", photo.ID)
+		assert.Equal(t, 50-i, rl.Remaining)
+	}
+
+	assert.Equal(t, 3, requests)
+	assert.Equal(t, unsplash.CacheStats{Hits: 2, Misses: 1}, c.CacheStats())
+}
+
+func TestWithCache_Memory(t *testing.T) {
+	testCache(t, unsplash.NewMemoryCache(10))
+}
+
+func TestWithCache_Disk(t *testing.T) {
+	cache, err := unsplash.NewDiskCache(t.TempDir())
+	require.Nil(t, err)
+
+	testCache(t, cache)
+
+	cache.Set("key", []byte("value"))
+	value, ok := cache.Get("key")
+	assert.True(t, ok)
+	assert.Equal(t, "value", string(value))
+
+	cache.Delete("key")
+	_, ok = cache.Get("key")
+	assert.False(t, ok)
+}
+
+func TestMemoryCache_Evict(t *testing.T) {
+	cache := unsplash.NewMemoryCache(2)
+	cache.Set("a", []byte("1"))
+	cache.Set("b", []byte("2"))
+	cache.Get("a")
+	cache.Set("c", []byte("3"))
+
+	_, ok := cache.Get("b")
+	assert.False(t, ok)
+
+	for _, key := range []string{"a", "c"} {
+		_, ok := cache.Get(key)
+		assert.True(t, ok, key)
+	}
+}
+
+func TestWithCache_SkipsImagesAndLargeBodies(t *testing.T) {
+	large := `{"id":"abc","description":"` + strings.Repeat("x", 1<<20) + `"}`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Etag", `"v1"`)
+		switch r.URL.Path {
+		case "/image.jpg":
+			w.Header().Set("Content-Type", "image/jpeg")
+			w.Write([]byte("jpeg bytes"))
+		case "/photos/large":
+			// chunked response of unknown length
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(large[:10]))
+			w.(http.Flusher).Flush()
+			w.Write([]byte(large[10:]))
+		}
+	}))
+	defer srv.Close()
+
+	cache := unsplash.NewMemoryCache(10)
+	c, err := unsplash.New(unsplash.WithBaseURL(srv.URL), unsplash.WithCache(cache))
+	require.Nil(t, err)
+
+	req, err := http.NewRequest(http.MethodGet, "/image.jpg", nil)
+	require.Nil(t, err)
+	_, err = c.Do(context.Background(), req, nil)
+	require.Nil(t, err)
+
+	photo, _, err := c.GetPhoto(context.Background(), "large")
+	require.Nil(t, err)
+	assert.Len(t, photo.Description, 1<<20)
+
+	for _, path := range []string{"/image.jpg", "/photos/large"} {
+		_, ok := cache.Get(srv.URL + path)
+		assert.False(t, ok, path)
+	}
+}
@@ -1,13 +1,32 @@
 package unsplash
 
 import (
+	"context"
+	"encoding/json"
 	"github.com/sirupsen/logrus"
+	"io"
 	"net/http"
+	"net/url"
+	"strings"
 )
 
 type Client struct {
 	httpClient *http.Client
-	log        *logrus.Logger
+	// imageClient downloads image bytes from the CDN. It is not wrapped by
+	// the API transport: no auth, rate limits, retries or caching.
+	imageClient *http.Client
+	log         *logrus.Logger
+	baseURL     string
+	scopes      []Scope
+	limiter     *rateLimiter
+	retry       *RetryPolicy
+	cache       *httpCache
+	responses   *responseCache
+	// strictLimits makes methods fail with ErrInvalidLimits when rate limit
+	// headers are missing.
+	strictLimits bool
+	// appName is utm_source of attribution links.
+	appName string
 }
 
 type Option func(*Client) error
@@ -19,6 +38,16 @@ func WithHttpClient(httpClient *http.Client) Option {
 	}
 }
 
+// WithImageHttpClient sets the http client used by DownloadPhoto to fetch
+// image bytes from the CDN (default http.DefaultClient). It must not add
+// API credentials.
+func WithImageHttpClient(httpClient *http.Client) Option {
+	return func(c *Client) error {
+		c.imageClient = httpClient
+		return nil
+	}
+}
+
 func WithLogrus(logger *logrus.Logger) Option {
 	return func(c *Client) error {
 		c.log = logger
@@ -26,10 +55,64 @@ func WithLogrus(logger *logrus.Logger) Option {
 	}
 }
 
+// WithBaseURL overrides the API root (default https://api.unsplash.com). Useful
+// for proxies, gateways and fake servers in tests.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) error {
+		u, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+
+		if u.Scheme == "" || u.Host == "" {
+			return ErrBadRequest
+		}
+
+		c.baseURL = strings.TrimRight(baseURL, "/")
+		return nil
+	}
+}
+
+// WithScopes declares scopes granted to the access token. When set, methods
+// which require a scope that is not granted fail with ErrForbidden without
+// sending a request.
+func WithScopes(scopes ...Scope) Option {
+	return func(c *Client) error {
+		c.scopes = scopes
+		return nil
+	}
+}
+
+// WithStrictRateLimits makes every method fail with ErrInvalidLimits when the
+// response has no valid rate limit headers. By default such responses are
+// processed as usual and a nil *RateLimit is returned.
+func WithStrictRateLimits() Option {
+	return func(c *Client) error {
+		c.strictLimits = true
+		return nil
+	}
+}
+
+// WithAppName sets the application name used as utm_source in links
+// returned by Attribution.
+func WithAppName(name string) Option {
+	return func(c *Client) error {
+		if name == "" {
+			return ErrBadRequest
+		}
+
+		c.appName = name
+		return nil
+	}
+}
+
 func New(options ...Option) (*Client, error) {
 	c := Client{
-		httpClient: http.DefaultClient,
-		log:        logrus.New(),
+		httpClient:  http.DefaultClient,
+		imageClient: http.DefaultClient,
+		log:         logrus.New(),
+		baseURL:     apiURL,
+		limiter:     newRateLimiter(RateLimitPolicyNone),
 	}
 
 	for _, option := range options {
@@ -38,7 +121,108 @@ func New(options ...Option) (*Client, error) {
 		}
 	}
 
-	c.httpClient = newTransport(c.httpClient)
+	c.httpClient = newTransport(c.httpClient, c.limiter, c.retry, c.cache, c.log)
 
 	return &c, nil
 }
+
+// requireScope checks that scope was granted. Scopes are not checked when
+// they were not declared with WithScopes.
+func (c *Client) requireScope(scope Scope) error {
+	if c.scopes == nil {
+		return nil
+	}
+
+	for _, s := range c.scopes {
+		if s == scope {
+			return nil
+		}
+	}
+
+	return ErrForbidden
+}
+
+// getLimits parses rate limit headers. Unless strict mode is enabled missing
+// or invalid headers are not an error and nil limits are returned.
+func (c *Client) getLimits(resp *http.Response) (*RateLimit, error) {
+	rl, err := getLimits(resp)
+	if err != nil && !c.strictLimits {
+		return nil, nil
+	}
+
+	return rl, err
+}
+
+// Do sends req through the client: it gets authorization and API version
+// headers, is subject to rate limit policy and retries, and non-2xx
+// responses are returned as *APIError. The JSON response body is decoded into
+// v unless v is nil. Relative request URLs (like "/photos/abc/related") are
+// resolved against the base URL.
+func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*RateLimit, error) {
+	if !req.URL.IsAbs() {
+		u, err := url.Parse(c.baseURL + req.URL.String())
+		if err != nil {
+			return nil, err
+		}
+
+		req = req.WithContext(ctx)
+		req.URL = u
+	}
+
+	_, rl, err := c.send(ctx, req, 0, v)
+
+	return rl, err
+}
+
+// do sends a request to path relative to the base URL and decodes the
+// response into out.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body io.Reader, expectStatus int, out interface{}) (*RateLimit, error) {
+	req, err := c.newRequest(method, path, query, body)
+	if err != nil {
+		return nil, err
+	}
+
+	_, rl, err := c.send(ctx, req, expectStatus, out)
+
+	return rl, err
+}
+
+func (c *Client) newRequest(method, path string, query url.Values, body io.Reader) (*http.Request, error) {
+	u := c.baseURL + path
+	if len(query) != 0 {
+		u += "?" + query.Encode()
+	}
+
+	return http.NewRequest(method, u, body)
+}
+
+// send executes req and decodes the JSON response body into out. Responses
+// with status other than expectStatus are errors; expectStatus 0 accepts any
+// 2xx status. The returned response has its body already closed.
+func (c *Client) send(ctx context.Context, req *http.Request, expectStatus int, out interface{}) (*http.Response, *RateLimit, error) {
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	rl, err := c.getLimits(resp)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch {
+	case resp.StatusCode == expectStatus:
+	case expectStatus == 0 && resp.StatusCode >= 200 && resp.StatusCode < 300:
+	default:
+		return nil, rl, handleError(resp)
+	}
+
+	if out != nil && resp.StatusCode != http.StatusNoContent {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return nil, rl, err
+		}
+	}
+
+	return resp, rl, nil
+}
@@ -0,0 +1,112 @@
+package unsplash_test
+
+import (
+	"context"
+	"errors"
+	"github.com/kazhuravlev/go-unsplash/unsplash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newTestClient starts a fake API server with the given handler and returns a
+// client pointed at it. Rate limit headers are always set.
+func newTestClient(t *testing.T, h http.HandlerFunc, options ...unsplash.Option) (*unsplash.Client, func()) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Ratelimit-Limit", "50")
+		w.Header().Set("X-Ratelimit-Remaining", "49")
+		h(w, r)
+	}))
+
+	c, err := unsplash.New(append([]unsplash.Option{unsplash.WithBaseURL(srv.URL)}, options...)...)
+	require.Nil(t, err)
+
+	return c, srv.Close
+}
+
+func TestWithBaseURL(t *testing.T) {
+	var path string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.Header().Set("X-Ratelimit-Limit", "50")
+		w.Header().Set("X-Ratelimit-Remaining", "49")
+		w.Write([]byte(`{"id":"abc"}`))
+	}))
+	defer srv.Close()
+
+	c, err := unsplash.New(unsplash.WithBaseURL(srv.URL + "/"))
+	require.Nil(t, err)
+
+	photo, rl, err := c.GetPhoto(context.Background(), "abc")
+	require.Nil(t, err)
+	assert.Equal(t, "/photos/abc", path)
+	assert.Equal(t, "abc", photo.ID)
+	assert.Equal(t, 49, rl.Remaining)
+}
+
+func TestWithBaseURL_Invalid(t *testing.T) {
+	_, err := unsplash.New(unsplash.WithBaseURL("api.unsplash.com"))
+	assert.Equal(t, unsplash.ErrBadRequest, err)
+}
+
+func TestMissingRateLimits(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/photos/broken" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(`{"id":"abc"}`))
+	}))
+	defer srv.Close()
+
+	c, err := unsplash.New(unsplash.WithBaseURL(srv.URL))
+	require.Nil(t, err)
+
+	photo, rl, err := c.GetPhoto(context.Background(), "abc")
+	require.Nil(t, err)
+	assert.Nil(t, rl)
+	assert.Equal(t, "abc", photo.ID)
+
+	_, _, err = c.GetPhoto(context.Background(), "broken")
+	assert.True(t, errors.Is(err, unsplash.ErrServer))
+
+	c, err = unsplash.New(unsplash.WithBaseURL(srv.URL), unsplash.WithStrictRateLimits())
+	require.Nil(t, err)
+
+	_, _, err = c.GetPhoto(context.Background(), "abc")
+	assert.Equal(t, unsplash.ErrInvalidLimits, err)
+}
+
+func TestDo(t *testing.T) {
+	c, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "v1", r.Header.Get("Accept-Version"))
+		switch r.URL.Path {
+		case "/photos/abc/related":
+			w.Write([]byte(`{"results":[{"id":"def"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors":["Couldn't find Photo"]}`))
+		}
+	})
+	defer done()
+
+	req, err := http.NewRequest(http.MethodGet, "/photos/abc/related", nil)
+	require.Nil(t, err)
+
+	var related struct {
+		Results []unsplash.Photo `json:"results"`
+	}
+	rl, err := c.Do(context.Background(), req, &related)
+	require.Nil(t, err)
+	assert.Equal(t, 49, rl.Remaining)
+	require.Len(t, related.Results, 1)
+	assert.Equal(t, "def", related.Results[0].ID)
+
+	req, err = http.NewRequest(http.MethodGet, "/photos/unknown/related", nil)
+	require.Nil(t, err)
+
+	_, err = c.Do(context.Background(), req, nil)
+	assert.True(t, errors.Is(err, unsplash.ErrNotFound))
+}
@@ -0,0 +1,354 @@
+package unsplash
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type ListCollectionsOptions struct {
+	// Page Page number to retrieve. (Optional; default: 1)
+	Page int
+	// PerPage Number of items per page. (Optional; default: 10)
+	PerPage int
+}
+
+func (o ListCollectionsOptions) validate() error {
+	if o.Page < 0 {
+		return ErrBadRequest
+	}
+
+	if o.PerPage < 0 {
+		return ErrBadRequest
+	}
+
+	if o.PerPage > maxListItems {
+		return ErrBadRequest
+	}
+
+	return nil
+}
+
+func (o ListCollectionsOptions) query() url.Values {
+	query := url.Values{}
+	if o.Page == 0 {
+		o.Page = 1
+	}
+
+	if o.PerPage == 0 {
+		o.PerPage = 10
+	}
+
+	query.Set("page", strconv.Itoa(o.Page))
+	query.Set("per_page", strconv.Itoa(o.PerPage))
+
+	return query
+}
+
+func (c *Client) ListCollections(ctx context.Context, opts ListCollectionsOptions) ([]Collection, *RateLimit, error) {
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	var collections []Collection
+	rl, err := c.do(ctx, http.MethodGet, "/collections", opts.query(), nil, http.StatusOK, &collections)
+	if err != nil {
+		return nil, rl, err
+	}
+
+	return collections, rl, nil
+}
+
+func (c *Client) GetCollection(ctx context.Context, id string) (*Collection, *RateLimit, error) {
+	if id == "" {
+		return nil, nil, ErrBadRequest
+	}
+
+	var collection Collection
+	rl, err := c.doCached(ctx, EndpointClassCollection, fmt.Sprintf("/collections/%s", id), nil, &collection)
+	if err != nil {
+		return nil, rl, err
+	}
+
+	return &collection, rl, nil
+}
+
+type ListCollectionPhotosOptions struct {
+	// ID The collection’s ID.
+	ID string
+	// Page Page number to retrieve. (Optional; default: 1)
+	Page int
+	// PerPage Number of items per page. (Optional; default: 10)
+	PerPage int
+	// Orientation Filter by photo orientation. Valid values are landscape, portrait, and squarish.
+	Orientation Orientation
+}
+
+func (o ListCollectionPhotosOptions) validate() error {
+	if o.ID == "" {
+		return ErrBadRequest
+	}
+
+	if o.Page < 0 {
+		return ErrBadRequest
+	}
+
+	if o.PerPage < 0 {
+		return ErrBadRequest
+	}
+
+	if o.PerPage > maxListItems {
+		return ErrBadRequest
+	}
+
+	switch o.Orientation {
+	case "", OrientationLandscape, OrientationPortrait, OrientationSquarish:
+	default:
+		return ErrBadRequest
+	}
+
+	return nil
+}
+
+func (o ListCollectionPhotosOptions) query() url.Values {
+	query := url.Values{}
+	if o.Page == 0 {
+		o.Page = 1
+	}
+
+	if o.PerPage == 0 {
+		o.PerPage = 10
+	}
+
+	if o.Orientation != "" {
+		query.Set("orientation", string(o.Orientation))
+	}
+
+	query.Set("page", strconv.Itoa(o.Page))
+	query.Set("per_page", strconv.Itoa(o.PerPage))
+
+	return query
+}
+
+func (c *Client) ListCollectionPhotos(ctx context.Context, opts ListCollectionPhotosOptions) ([]Photo, *RateLimit, error) {
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	var photos []Photo
+	rl, err := c.doCached(ctx, EndpointClassCollection, fmt.Sprintf("/collections/%s/photos", opts.ID), opts.query(), &photos)
+	if err != nil {
+		return nil, rl, err
+	}
+
+	return photos, rl, nil
+}
+
+func (c *Client) ListRelatedCollections(ctx context.Context, id string) ([]Collection, *RateLimit, error) {
+	if id == "" {
+		return nil, nil, ErrBadRequest
+	}
+
+	var collections []Collection
+	rl, err := c.do(ctx, http.MethodGet, fmt.Sprintf("/collections/%s/related", id), nil, nil, http.StatusOK, &collections)
+	if err != nil {
+		return nil, rl, err
+	}
+
+	return collections, rl, nil
+}
+
+type CreateCollectionOptions struct {
+	// Title The title of the collection.
+	Title string
+	// Description The collection’s description. (Optional)
+	Description string
+	// Private Whether to make this collection private. (Optional; default false)
+	Private bool
+}
+
+func (o CreateCollectionOptions) validate() error {
+	if o.Title == "" {
+		return ErrBadRequest
+	}
+
+	return nil
+}
+
+func (o CreateCollectionOptions) query() url.Values {
+	query := url.Values{}
+
+	query.Set("title", o.Title)
+
+	if o.Description != "" {
+		query.Set("description", o.Description)
+	}
+
+	query.Set("private", strconv.FormatBool(o.Private))
+
+	return query
+}
+
+// CreateCollection requires ScopeWriteCollections.
+func (c *Client) CreateCollection(ctx context.Context, opts CreateCollectionOptions) (*Collection, *RateLimit, error) {
+	if err := c.requireScope(ScopeWriteCollections); err != nil {
+		return nil, nil, err
+	}
+
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	var collection Collection
+	rl, err := c.do(ctx, http.MethodPost, "/collections", opts.query(), nil, http.StatusCreated, &collection)
+	if err != nil {
+		return nil, rl, err
+	}
+
+	return &collection, rl, nil
+}
+
+type UpdateCollectionOptions struct {
+	// ID The collection’s ID.
+	ID string
+	// Title The title of the collection. (Optional)
+	Title string
+	// Description The collection’s description. (Optional)
+	Description string
+	// Private Whether to make this collection private. (Optional; nil keeps current value)
+	Private *bool
+}
+
+func (o UpdateCollectionOptions) validate() error {
+	if o.ID == "" {
+		return ErrBadRequest
+	}
+
+	return nil
+}
+
+func (o UpdateCollectionOptions) query() url.Values {
+	query := url.Values{}
+
+	if o.Title != "" {
+		query.Set("title", o.Title)
+	}
+
+	if o.Description != "" {
+		query.Set("description", o.Description)
+	}
+
+	if o.Private != nil {
+		query.Set("private", strconv.FormatBool(*o.Private))
+	}
+
+	return query
+}
+
+// UpdateCollection requires ScopeWriteCollections.
+func (c *Client) UpdateCollection(ctx context.Context, opts UpdateCollectionOptions) (*Collection, *RateLimit, error) {
+	if err := c.requireScope(ScopeWriteCollections); err != nil {
+		return nil, nil, err
+	}
+
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	var collection Collection
+	rl, err := c.do(ctx, http.MethodPut, fmt.Sprintf("/collections/%s", opts.ID), opts.query(), nil, http.StatusOK, &collection)
+	c.invalidateCollection(opts.ID, "")
+
+	if err != nil {
+		return nil, rl, err
+	}
+
+	return &collection, rl, nil
+}
+
+// DeleteCollection requires ScopeWriteCollections.
+func (c *Client) DeleteCollection(ctx context.Context, id string) (*RateLimit, error) {
+	if err := c.requireScope(ScopeWriteCollections); err != nil {
+		return nil, err
+	}
+
+	if id == "" {
+		return nil, ErrBadRequest
+	}
+
+	rl, err := c.do(ctx, http.MethodDelete, fmt.Sprintf("/collections/%s", id), nil, nil, http.StatusNoContent, nil)
+	c.invalidateCollection(id, "")
+
+	return rl, err
+}
+
+type CollectionPhotoOptions struct {
+	// CollectionID The collection’s ID.
+	CollectionID string
+	// PhotoID The photo’s ID.
+	PhotoID string
+}
+
+func (o CollectionPhotoOptions) validate() error {
+	if o.CollectionID == "" {
+		return ErrBadRequest
+	}
+
+	if o.PhotoID == "" {
+		return ErrBadRequest
+	}
+
+	return nil
+}
+
+func (o CollectionPhotoOptions) query() url.Values {
+	query := url.Values{}
+
+	query.Set("photo_id", o.PhotoID)
+
+	return query
+}
+
+// AddPhotoToCollection requires ScopeWriteCollections.
+func (c *Client) AddPhotoToCollection(ctx context.Context, opts CollectionPhotoOptions) (*CollectedPhoto, *RateLimit, error) {
+	if err := c.requireScope(ScopeWriteCollections); err != nil {
+		return nil, nil, err
+	}
+
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	var collected CollectedPhoto
+	rl, err := c.do(ctx, http.MethodPost, fmt.Sprintf("/collections/%s/add", opts.CollectionID), opts.query(), nil, http.StatusCreated, &collected)
+	c.invalidateCollection(opts.CollectionID, opts.PhotoID)
+
+	if err != nil {
+		return nil, rl, err
+	}
+
+	return &collected, rl, nil
+}
+
+// RemovePhotoFromCollection requires ScopeWriteCollections.
+func (c *Client) RemovePhotoFromCollection(ctx context.Context, opts CollectionPhotoOptions) (*CollectedPhoto, *RateLimit, error) {
+	if err := c.requireScope(ScopeWriteCollections); err != nil {
+		return nil, nil, err
+	}
+
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	var collected CollectedPhoto
+	rl, err := c.do(ctx, http.MethodDelete, fmt.Sprintf("/collections/%s/remove", opts.CollectionID), opts.query(), nil, http.StatusOK, &collected)
+	c.invalidateCollection(opts.CollectionID, opts.PhotoID)
+
+	if err != nil {
+		return nil, rl, err
+	}
+
+	return &collected, rl, nil
+}
@@ -0,0 +1,119 @@
+package unsplash_test
+
+import (
+	"context"
+	"github.com/kazhuravlev/go-unsplash/unsplash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"testing"
+)
+
+func TestListCollections(t *testing.T) {
+	c, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/collections", r.URL.Path)
+		assert.Equal(t, "5", r.URL.Query().Get("per_page"))
+		w.Write([]byte(`[{"id":"1"},{"id":"2"}]`))
+	})
+	defer done()
+
+	collections, rl, err := c.ListCollections(context.Background(), unsplash.ListCollectionsOptions{PerPage: 5})
+	require.Nil(t, err)
+	assert.NotNil(t, rl)
+	assert.Len(t, collections, 2)
+}
+
+func TestGetCollection(t *testing.T) {
+	c, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/collections/42", r.URL.Path)
+		w.Write([]byte(`{"id":"42","title":"cars"}`))
+	})
+	defer done()
+
+	collection, _, err := c.GetCollection(context.Background(), "42")
+	require.Nil(t, err)
+	assert.Equal(t, "cars", collection.Title)
+}
+
+func TestListCollectionPhotos(t *testing.T) {
+	c, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/collections/42/photos", r.URL.Path)
+		assert.Equal(t, "squarish", r.URL.Query().Get("orientation"))
+		w.Write([]byte(`[{"id":"a"}]`))
+	})
+	defer done()
+
+	photos, _, err := c.ListCollectionPhotos(context.Background(), unsplash.ListCollectionPhotosOptions{ID: "42", Orientation: unsplash.OrientationSquarish})
+	require.Nil(t, err)
+	assert.Len(t, photos, 1)
+}
+
+func TestListRelatedCollections(t *testing.T) {
+	c, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/collections/42/related", r.URL.Path)
+		w.Write([]byte(`[{"id":"43"}]`))
+	})
+	defer done()
+
+	collections, _, err := c.ListRelatedCollections(context.Background(), "42")
+	require.Nil(t, err)
+	assert.Len(t, collections, 1)
+}
+
+func TestCollectionWriteOperations(t *testing.T) {
+	c, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /collections":
+			assert.Equal(t, "moodboard", r.URL.Query().Get("title"))
+			assert.Equal(t, "true", r.URL.Query().Get("private"))
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":"42","title":"moodboard","private":true}`))
+		case "PUT /collections/42":
+			assert.Equal(t, "false", r.URL.Query().Get("private"))
+			w.Write([]byte(`{"id":"42","title":"moodboard"}`))
+		case "POST /collections/42/add":
+			assert.Equal(t, "abc", r.URL.Query().Get("photo_id"))
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"photo":{"id":"abc"},"collection":{"id":"42"}}`))
+		case "DELETE /collections/42/remove":
+			w.Write([]byte(`{"photo":{"id":"abc"},"collection":{"id":"42"}}`))
+		case "DELETE /collections/42":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	defer done()
+
+	ctx := context.Background()
+
+	collection, _, err := c.CreateCollection(ctx, unsplash.CreateCollectionOptions{Title: "moodboard", Private: true})
+	require.Nil(t, err)
+	assert.True(t, collection.Private)
+
+	private := false
+	collection, _, err = c.UpdateCollection(ctx, unsplash.UpdateCollectionOptions{ID: "42", Private: &private})
+	require.Nil(t, err)
+	assert.False(t, collection.Private)
+
+	collected, _, err := c.AddPhotoToCollection(ctx, unsplash.CollectionPhotoOptions{CollectionID: "42", PhotoID: "abc"})
+	require.Nil(t, err)
+	assert.Equal(t, "abc", collected.Photo.ID)
+
+	_, _, err = c.RemovePhotoFromCollection(ctx, unsplash.CollectionPhotoOptions{CollectionID: "42", PhotoID: "abc"})
+	require.Nil(t, err)
+
+	_, err = c.DeleteCollection(ctx, "42")
+	require.Nil(t, err)
+}
+
+func TestCollectionWriteOperations_Scope(t *testing.T) {
+	c, err := unsplash.New(unsplash.WithBaseURL("http://127.0.0.1:1"), unsplash.WithScopes(unsplash.ScopePublic))
+	require.Nil(t, err)
+
+	_, _, err = c.CreateCollection(context.Background(), unsplash.CreateCollectionOptions{Title: "moodboard"})
+	assert.Equal(t, unsplash.ErrForbidden, err)
+
+	_, err = c.DeleteCollection(context.Background(), "42")
+	assert.Equal(t, unsplash.ErrForbidden, err)
+}
@@ -0,0 +1,32 @@
+package unsplash
+
+import (
+	"fmt"
+	"image/color"
+	"strconv"
+	"strings"
+)
+
+// Color is a hex color like "#60544D". Photo.Color is the dominant color of
+// the photo and can be used as a placeholder.
+type Color string
+
+// Parse returns the color as color.RGBA. Both "#RRGGBB" and "#RGB" forms are
+// supported; the leading "#" is optional.
+func (c Color) Parse() (color.RGBA, error) {
+	s := strings.TrimPrefix(string(c), "#")
+	if len(s) == 3 {
+		s = string([]byte{s[0], s[0], s[1], s[1], s[2], s[2]})
+	}
+
+	if len(s) != 6 {
+		return color.RGBA{}, fmt.Errorf("unsplash: invalid color %q", string(c))
+	}
+
+	v, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		return color.RGBA{}, fmt.Errorf("unsplash: invalid color %q: %w", string(c), err)
+	}
+
+	return color.RGBA{R: uint8(v >> 16), G: uint8(v >> 8), B: uint8(v), A: 0xff}, nil
+}
@@ -0,0 +1,29 @@
+package unsplash_test
+
+import (
+	"errors"
+	"github.com/kazhuravlev/go-unsplash/unsplash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"image/color"
+	"testing"
+)
+
+func TestColor_Parse(t *testing.T) {
+	for in, expected := range map[unsplash.Color]color.RGBA{
+		"#60544D": {R: 0x60, G: 0x54, B: 0x4D, A: 0xff},
+		"60544d":  {R: 0x60, G: 0x54, B: 0x4D, A: 0xff},
+		"#fa0":    {R: 0xff, G: 0xaa, B: 0x00, A: 0xff},
+	} {
+		c, err := in.Parse()
+		require.Nil(t, err, in)
+		assert.Equal(t, expected, c, in)
+	}
+
+	for _, in := range []unsplash.Color{"", "#12345", "#GGGGGG", "#+12345"} {
+		_, err := in.Parse()
+		require.NotNil(t, err, in)
+		assert.False(t, errors.Is(err, unsplash.ErrBadRequest), in)
+		assert.Contains(t, err.Error(), "invalid color", in)
+	}
+}
@@ -0,0 +1,92 @@
+package unsplash
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// TrackDownload triggers a download event for the photo as required by the
+// Unsplash API guidelines. It must be called whenever a photo is used (set as
+// a wallpaper, inserted in a document, etc.). The request goes to
+// photo.Links.DownloadLocation, which keeps the ixid tracking parameter, when
+// it points to the API host.
+func (c *Client) TrackDownload(ctx context.Context, photo *Photo) (*PhotoDownload, *RateLimit, error) {
+	if photo == nil || photo.ID == "" {
+		return nil, nil, ErrBadRequest
+	}
+
+	req, err := http.NewRequest(http.MethodGet, c.downloadLocation(photo), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var download PhotoDownload
+	_, rl, err := c.send(ctx, req, http.StatusOK, &download)
+	if err != nil {
+		return nil, rl, err
+	}
+
+	return &download, rl, nil
+}
+
+// DownloadPhoto tracks the download and then writes the image bytes to w.
+// Image bytes are fetched with the image client (see WithImageHttpClient), so
+// the CDN request carries no credentials and does not affect rate limits or
+// the HTTP cache.
+func (c *Client) DownloadPhoto(ctx context.Context, photo *Photo, w io.Writer) (*RateLimit, error) {
+	download, rl, err := c.TrackDownload(ctx, photo)
+	if err != nil {
+		return rl, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, download.URL, nil)
+	if err != nil {
+		return rl, err
+	}
+
+	resp, err := c.imageClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return rl, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return rl, handleError(resp)
+	}
+
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		return rl, err
+	}
+
+	return rl, nil
+}
+
+// downloadLocation returns the download endpoint of photo relative to the
+// configured base URL. Locations on other hosts are replaced with the
+// endpoint built from the photo ID, so credentials are never sent there.
+func (c *Client) downloadLocation(photo *Photo) string {
+	fallback := fmt.Sprintf("%s/photos/%s/download", c.baseURL, photo.ID)
+
+	location, err := url.Parse(photo.Links.DownloadLocation)
+	if err != nil || photo.Links.DownloadLocation == "" {
+		return fallback
+	}
+
+	if api, _ := url.Parse(apiURL); sameOrigin(location, api) {
+		return c.baseURL + location.RequestURI()
+	}
+
+	if base, _ := url.Parse(c.baseURL); sameOrigin(location, base) {
+		return location.String()
+	}
+
+	return fallback
+}
+
+// sameOrigin reports whether a and b have the same scheme and host.
+func sameOrigin(a, b *url.URL) bool {
+	return b != nil && a.Scheme == b.Scheme && a.Host == b.Host && a.User == nil
+}
@@ -0,0 +1,118 @@
+package unsplash_test
+
+import (
+	"bytes"
+	"context"
+	"github.com/kazhuravlev/go-unsplash/unsplash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTrackDownload(t *testing.T) {
+	c, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/photos/abc/download", r.URL.Path)
+		assert.Equal(t, "xyz", r.URL.Query().Get("ixid"))
+		w.Write([]byte(`{"url":"https://images.unsplash.com/photo-1"}`))
+	})
+	defer done()
+
+	photo := unsplash.Photo{ID: "abc"}
+	photo.Links.DownloadLocation = "https://api.unsplash.com/photos/abc/download?ixid=xyz"
+
+	download, rl, err := c.TrackDownload(context.Background(), &photo)
+	require.Nil(t, err)
+	assert.NotNil(t, rl)
+	assert.Equal(t, "https://images.unsplash.com/photo-1", download.URL)
+
+	_, _, err = c.TrackDownload(context.Background(), nil)
+	assert.Equal(t, unsplash.ErrBadRequest, err)
+}
+
+func TestDownloadPhoto(t *testing.T) {
+	c, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/photos/abc/download":
+			w.Write([]byte(`{"url":"http://` + r.Host + `/image.jpg"}`))
+		case "/image.jpg":
+			w.Write([]byte("jpeg bytes"))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	})
+	defer done()
+
+	var buf bytes.Buffer
+	_, err := c.DownloadPhoto(context.Background(), &unsplash.Photo{ID: "abc"}, &buf)
+	require.Nil(t, err)
+	assert.Equal(t, "jpeg bytes", buf.String())
+}
+
+// authTransport adds credentials like oauth2.Transport does.
+type authTransport struct{}
+
+func (authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer SECRET")
+
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestDownloadPhoto_ImageRequest(t *testing.T) {
+	var imageAuth []string
+	cdn := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		imageAuth = r.Header.Values("Authorization")
+		// must not be taken as API rate limits
+		w.Header().Set("X-Ratelimit-Limit", "50")
+		w.Header().Set("X-Ratelimit-Remaining", "0")
+		w.Header().Set("ETag", `"image"`)
+		w.Write([]byte("jpeg bytes"))
+	}))
+	defer cdn.Close()
+
+	var apiAuth string
+	c, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		apiAuth = r.Header.Get("Authorization")
+		w.Write([]byte(`{"url":"` + cdn.URL + `/image.jpg"}`))
+	},
+		unsplash.WithHttpClient(&http.Client{Transport: authTransport{}}),
+		unsplash.WithRateLimitPolicy(unsplash.RateLimitPolicyFailFast),
+		unsplash.WithCache(unsplash.NewMemoryCache(10)),
+	)
+	defer done()
+
+	var buf bytes.Buffer
+	_, err := c.DownloadPhoto(context.Background(), &unsplash.Photo{ID: "abc"}, &buf)
+	require.Nil(t, err)
+	assert.Equal(t, "jpeg bytes", buf.String())
+
+	assert.Equal(t, "Bearer SECRET", apiAuth)
+	assert.Empty(t, imageAuth)
+	assert.Equal(t, &unsplash.RateLimit{Limit: 50, Remaining: 49}, c.RateLimit())
+	// only the download tracking request went through the cache
+	assert.Equal(t, unsplash.CacheStats{Misses: 1}, c.CacheStats())
+}
+
+func TestTrackDownload_ForeignLocation(t *testing.T) {
+	c, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/photos/abc/download", r.URL.Path)
+		assert.Empty(t, r.URL.Query().Get("ixid"))
+		w.Write([]byte(`{"url":"https://images.unsplash.com/photo-1"}`))
+	})
+	defer done()
+
+	for _, location := range []string{
+		"https://api.unsplash.com.evil/photos/abc/download?ixid=xyz",
+		"https://api.unsplash.com@evil/photos/abc/download?ixid=xyz",
+		"http://api.unsplash.com/photos/abc/download?ixid=xyz",
+		"https://evil/photos/abc/download?ixid=xyz",
+	} {
+		photo := unsplash.Photo{ID: "abc"}
+		photo.Links.DownloadLocation = location
+
+		_, _, err := c.TrackDownload(context.Background(), &photo)
+		require.Nil(t, err, location)
+	}
+}
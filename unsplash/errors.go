@@ -0,0 +1,98 @@
+package unsplash
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// maxErrorBody limits the amount of error response body read into APIError.
+const maxErrorBody = 64 << 10
+
+// APIError is returned when the API responds with an unexpected status code.
+// It matches the sentinel errors (ErrBadRequest, ErrNotFound, ...) with
+// errors.Is.
+type APIError struct {
+	StatusCode int
+	// Messages are taken from the "errors" field of the response body.
+	Messages  []string
+	Method    string
+	URL       string
+	RateLimit *RateLimit
+	// Body is the raw response body.
+	Body []byte
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("unsplash: %s %s: %d %s", e.Method, e.URL, e.StatusCode, http.StatusText(e.StatusCode))
+	if len(e.Messages) != 0 {
+		msg += ": " + strings.Join(e.Messages, "; ")
+	}
+
+	return msg
+}
+
+// Is reports whether target is one of the sentinels matching the status code.
+func (e *APIError) Is(target error) bool {
+	for _, err := range e.sentinels() {
+		if err == target {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (e *APIError) sentinels() []error {
+	switch {
+	case e.StatusCode == http.StatusBadRequest:
+		return []error{ErrBadRequest}
+	case e.StatusCode == http.StatusUnauthorized:
+		return []error{ErrUnauthorized}
+	case e.StatusCode == http.StatusForbidden:
+		// Unsplash responds 403 when the hourly quota is exhausted.
+		if e.RateLimit != nil && e.RateLimit.Remaining == 0 {
+			return []error{ErrForbidden, ErrRateLimited}
+		}
+		return []error{ErrForbidden}
+	case e.StatusCode == http.StatusNotFound:
+		return []error{ErrNotFound}
+	case e.StatusCode == http.StatusTooManyRequests:
+		return []error{ErrRateLimited}
+	case e.StatusCode >= http.StatusInternalServerError:
+		return []error{ErrServer}
+	default:
+		return []error{ErrUnexpected}
+	}
+}
+
+func handleError(resp *http.Response) error {
+	apiErr := APIError{
+		StatusCode: resp.StatusCode,
+	}
+
+	if resp.Request != nil {
+		apiErr.Method = resp.Request.Method
+		apiErr.URL = resp.Request.URL.String()
+	}
+
+	if rl, err := getLimits(resp); err == nil {
+		apiErr.RateLimit = rl
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	if err == nil {
+		apiErr.Body = body
+
+		var errResp struct {
+			Errors []string `json:"errors"`
+		}
+		if err := json.Unmarshal(body, &errResp); err == nil {
+			apiErr.Messages = errResp.Errors
+		}
+	}
+
+	return &apiErr
+}
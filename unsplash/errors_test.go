@@ -0,0 +1,68 @@
+package unsplash_test
+
+import (
+	"context"
+	"errors"
+	"github.com/kazhuravlev/go-unsplash/unsplash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"testing"
+)
+
+func TestAPIError(t *testing.T) {
+	cases := []struct {
+		status   int
+		expected []error
+	}{
+		{http.StatusBadRequest, []error{unsplash.ErrBadRequest}},
+		{http.StatusUnauthorized, []error{unsplash.ErrUnauthorized}},
+		{http.StatusForbidden, []error{unsplash.ErrForbidden}},
+		{http.StatusNotFound, []error{unsplash.ErrNotFound}},
+		{http.StatusTooManyRequests, []error{unsplash.ErrRateLimited}},
+		{http.StatusBadGateway, []error{unsplash.ErrServer}},
+		{http.StatusTeapot, []error{unsplash.ErrUnexpected}},
+	}
+
+	for _, tc := range cases {
+		c, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(tc.status)
+			w.Write([]byte(`{"errors":["first","second"]}`))
+		})
+
+		_, _, err := c.GetPhoto(context.Background(), "abc")
+		done()
+
+		var apiErr *unsplash.APIError
+		require.True(t, errors.As(err, &apiErr), tc.status)
+		assert.Equal(t, tc.status, apiErr.StatusCode)
+		assert.Equal(t, []string{"first", "second"}, apiErr.Messages)
+		assert.Equal(t, http.MethodGet, apiErr.Method)
+		assert.Contains(t, apiErr.URL, "/photos/abc")
+		require.NotNil(t, apiErr.RateLimit)
+		assert.Equal(t, 49, apiErr.RateLimit.Remaining)
+		assert.Contains(t, err.Error(), "first; second")
+		for _, expected := range tc.expected {
+			assert.True(t, errors.Is(err, expected), tc.status)
+		}
+		assert.False(t, errors.Is(err, unsplash.ErrInvalidLimits))
+	}
+}
+
+func TestAPIError_RateLimitExceeded(t *testing.T) {
+	c, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Ratelimit-Remaining", "0")
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`Rate Limit Exceeded`))
+	})
+	defer done()
+
+	_, _, err := c.GetPhoto(context.Background(), "abc")
+	assert.True(t, errors.Is(err, unsplash.ErrForbidden))
+	assert.True(t, errors.Is(err, unsplash.ErrRateLimited))
+
+	var apiErr *unsplash.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Empty(t, apiErr.Messages)
+	assert.Equal(t, "Rate Limit Exceeded", string(apiErr.Body))
+}
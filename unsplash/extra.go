@@ -0,0 +1,231 @@
+package unsplash
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// knownFields caches JSON names of struct fields by type.
+var knownFields sync.Map
+
+// jsonFields returns JSON names of fields of the struct type t, including
+// fields of embedded structs.
+func jsonFields(t reflect.Type) map[string]bool {
+	if fields, ok := knownFields.Load(t); ok {
+		return fields.(map[string]bool)
+	}
+
+	fields := make(map[string]bool)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+
+		tag := f.Tag.Get("json")
+		name := strings.Split(tag, ",")[0]
+		if name == "-" {
+			continue
+		}
+
+		if f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct {
+			for embedded := range jsonFields(f.Type) {
+				fields[embedded] = true
+			}
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+
+		fields[name] = true
+	}
+
+	knownFields.Store(t, fields)
+
+	return fields
+}
+
+// unknownFields returns members of the JSON object which have no matching
+// field in the struct type t. Keys are scanned first, so the object is decoded
+// again only when it has unknown members.
+func unknownFields(data []byte, t reflect.Type) (map[string]json.RawMessage, error) {
+	known := jsonFields(t)
+	if !hasUnknownKey(data, known) {
+		return nil, nil
+	}
+
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(data, &members); err != nil {
+		return nil, err
+	}
+
+	for name := range members {
+		if known[name] {
+			delete(members, name)
+		}
+	}
+
+	if len(members) == 0 {
+		return nil, nil
+	}
+
+	return members, nil
+}
+
+// hasUnknownKey reports whether the JSON object has a top-level key missing
+// from known. Keys with escapes are reported as unknown and left to the full
+// decode.
+func hasUnknownKey(data []byte, known map[string]bool) bool {
+	depth := 0
+	expectKey := false
+	for i := 0; i < len(data); i++ {
+		switch data[i] {
+		case '{', '[':
+			depth++
+			expectKey = depth == 1
+		case '}', ']':
+			depth--
+		case ',':
+			expectKey = depth == 1
+		case '"':
+			end, escaped := i+1, false
+			for ; end < len(data) && data[end] != '"'; end++ {
+				if data[end] == '\\' {
+					escaped = true
+					end++
+				}
+			}
+
+			if end >= len(data) {
+				return true
+			}
+
+			if expectKey {
+				if escaped || !known[string(data[i+1:end])] {
+					return true
+				}
+				expectKey = false
+			}
+
+			i = end
+		}
+	}
+
+	return false
+}
+
+func (p *Photo) UnmarshalJSON(data []byte) error {
+	type plain Photo
+	if err := json.Unmarshal(data, (*plain)(p)); err != nil {
+		return err
+	}
+
+	extra, err := unknownFields(data, reflect.TypeOf(p).Elem())
+	if err != nil {
+		return err
+	}
+
+	p.Extra = extra
+
+	return nil
+}
+
+// UnmarshalJSON is required because SearchPhoto would get the promoted
+// Photo.UnmarshalJSON otherwise and lose its own fields.
+func (p *SearchPhoto) UnmarshalJSON(data []byte) error {
+	type plain Photo
+	var v struct {
+		plain
+		Tags      []Tag `json:"tags"`
+		PhotoTags []Tag `json:"photo_tags"`
+	}
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	extra, err := unknownFields(data, reflect.TypeOf(p).Elem())
+	if err != nil {
+		return err
+	}
+
+	p.Photo, p.Tags, p.PhotoTags = Photo(v.plain), v.Tags, v.PhotoTags
+	p.Photo.Extra = extra
+
+	return nil
+}
+
+func (u *User) UnmarshalJSON(data []byte) error {
+	type plain User
+	if err := json.Unmarshal(data, (*plain)(u)); err != nil {
+		return err
+	}
+
+	extra, err := unknownFields(data, reflect.TypeOf(u).Elem())
+	if err != nil {
+		return err
+	}
+
+	u.Extra = extra
+
+	return nil
+}
+
+// UnmarshalJSON is required because CurrentUser would get the promoted
+// User.UnmarshalJSON otherwise and lose its own fields.
+func (u *CurrentUser) UnmarshalJSON(data []byte) error {
+	type plain User
+	var v struct {
+		plain
+		Email            string `json:"email"`
+		UploadsRemaining int    `json:"uploads_remaining"`
+		NumericID        int    `json:"numeric_id"`
+	}
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	extra, err := unknownFields(data, reflect.TypeOf(u).Elem())
+	if err != nil {
+		return err
+	}
+
+	u.User, u.Email, u.UploadsRemaining, u.NumericID = User(v.plain), v.Email, v.UploadsRemaining, v.NumericID
+	u.User.Extra = extra
+
+	return nil
+}
+
+func (c *Collection) UnmarshalJSON(data []byte) error {
+	type plain Collection
+	if err := json.Unmarshal(data, (*plain)(c)); err != nil {
+		return err
+	}
+
+	extra, err := unknownFields(data, reflect.TypeOf(c).Elem())
+	if err != nil {
+		return err
+	}
+
+	c.Extra = extra
+
+	return nil
+}
+
+func (t *Topic) UnmarshalJSON(data []byte) error {
+	type plain Topic
+	if err := json.Unmarshal(data, (*plain)(t)); err != nil {
+		return err
+	}
+
+	extra, err := unknownFields(data, reflect.TypeOf(t).Elem())
+	if err != nil {
+		return err
+	}
+
+	t.Extra = extra
+
+	return nil
+}
@@ -0,0 +1,196 @@
+package unsplash
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Fit is the imgix resize fit mode.
+type Fit string
+
+const (
+	FitClamp    Fit = "clamp"
+	FitClip     Fit = "clip"
+	FitCrop     Fit = "crop"
+	FitFaceArea Fit = "facearea"
+	FitFill     Fit = "fill"
+	FitFillMax  Fit = "fillmax"
+	FitMax      Fit = "max"
+	FitMin      Fit = "min"
+	FitScale    Fit = "scale"
+)
+
+// Crop is the imgix crop mode, used with FitCrop.
+type Crop string
+
+const (
+	CropTop        Crop = "top"
+	CropBottom     Crop = "bottom"
+	CropLeft       Crop = "left"
+	CropRight      Crop = "right"
+	CropFaces      Crop = "faces"
+	CropFocalPoint Crop = "focalpoint"
+	CropEdges      Crop = "edges"
+	CropEntropy    Crop = "entropy"
+)
+
+// Format is the imgix output format.
+type Format string
+
+const (
+	FormatJPG         Format = "jpg"
+	FormatProgressive Format = "pjpg"
+	FormatPNG         Format = "png"
+	FormatGIF         Format = "gif"
+	FormatWebP        Format = "webp"
+	FormatAVIF        Format = "avif"
+)
+
+const (
+	maxQuality = 100
+	maxBlur    = 2000
+)
+
+// PhotoURLBuilder builds imgix URLs on top of Urls.Raw. All parameters of the
+// raw URL, including ixid and ixlib, are preserved unless overridden.
+//
+// Setters return a modified copy, so one builder can be a base for several
+// URLs:
+//
+//	b, _ := photo.URLBuilder()
+//	b = b.Fit(FitMax).Quality(80)
+//	small, large := b.Width(400).String(), b.Width(1080).String()
+type PhotoURLBuilder struct {
+	base   url.URL
+	params url.Values
+}
+
+// NewPhotoURLBuilder creates a builder for the raw imgix URL.
+func NewPhotoURLBuilder(raw string) (PhotoURLBuilder, error) {
+	if raw == "" {
+		return PhotoURLBuilder{}, ErrBadRequest
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return PhotoURLBuilder{}, ErrBadRequest
+	}
+
+	params := u.Query()
+	u.RawQuery = ""
+
+	return PhotoURLBuilder{base: *u, params: params}, nil
+}
+
+// URLBuilder creates a builder for the photo's raw URL.
+func (p *Photo) URLBuilder() (PhotoURLBuilder, error) {
+	return NewPhotoURLBuilder(p.Urls.Raw)
+}
+
+func (b PhotoURLBuilder) with(key, value string) PhotoURLBuilder {
+	params := make(url.Values, len(b.params)+1)
+	for k, v := range b.params {
+		params[k] = v
+	}
+
+	if value == "" {
+		params.Del(key)
+	} else {
+		params.Set(key, value)
+	}
+
+	b.params = params
+
+	return b
+}
+
+func positive(v int) string {
+	if v <= 0 {
+		return ""
+	}
+
+	return strconv.Itoa(v)
+}
+
+// Width sets w in pixels. Zero removes it.
+func (b PhotoURLBuilder) Width(w int) PhotoURLBuilder {
+	return b.with("w", positive(w))
+}
+
+// Height sets h in pixels. Zero removes it.
+func (b PhotoURLBuilder) Height(h int) PhotoURLBuilder {
+	return b.with("h", positive(h))
+}
+
+// Fit sets how the image is resized to Width and Height.
+func (b PhotoURLBuilder) Fit(fit Fit) PhotoURLBuilder {
+	return b.with("fit", string(fit))
+}
+
+// Crop sets crop modes for FitCrop. Several modes are combined.
+func (b PhotoURLBuilder) Crop(crop ...Crop) PhotoURLBuilder {
+	modes := make([]string, len(crop))
+	for i := range crop {
+		modes[i] = string(crop[i])
+	}
+
+	return b.with("crop", strings.Join(modes, ","))
+}
+
+// Format sets output format fm.
+func (b PhotoURLBuilder) Format(fm Format) PhotoURLBuilder {
+	return b.with("fm", string(fm))
+}
+
+// Quality sets q in range 1..100. Zero removes it.
+func (b PhotoURLBuilder) Quality(q int) PhotoURLBuilder {
+	if q > maxQuality {
+		q = maxQuality
+	}
+
+	return b.with("q", positive(q))
+}
+
+// DPR sets device pixel ratio. Zero removes it.
+func (b PhotoURLBuilder) DPR(dpr float64) PhotoURLBuilder {
+	if dpr <= 0 {
+		return b.with("dpr", "")
+	}
+
+	return b.with("dpr", strconv.FormatFloat(dpr, 'f', -1, 64))
+}
+
+// AutoFormat adds auto=format, which lets imgix pick the best format
+// supported by the browser. Other auto values are kept.
+func (b PhotoURLBuilder) AutoFormat() PhotoURLBuilder {
+	var modes []string
+	if auto := b.params.Get("auto"); auto != "" {
+		modes = strings.Split(auto, ",")
+	}
+
+	for _, mode := range modes {
+		if mode == "format" {
+			return b
+		}
+	}
+
+	return b.with("auto", strings.Join(append(modes, "format"), ","))
+}
+
+// Blur sets blur radius in range 1..2000. Zero removes it.
+func (b PhotoURLBuilder) Blur(radius int) PhotoURLBuilder {
+	if radius > maxBlur {
+		radius = maxBlur
+	}
+
+	return b.with("blur", positive(radius))
+}
+
+// String returns the URL.
+func (b PhotoURLBuilder) String() string {
+	u := b.base
+	u.RawQuery = b.params.Encode()
+
+	return u.String()
+}
@@ -0,0 +1,68 @@
+package unsplash_test
+
+import (
+	"errors"
+	"github.com/kazhuravlev/go-unsplash/unsplash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/url"
+	"testing"
+)
+
+const rawURL = "https://images.unsplash.com/photo-1536167038724-17be8c5e6876?ixid=M3wxMjA3fDB8MXxzZWFyY2h8MXx8Y2FyfGVufDB8fHx8MTY5&ixlib=rb-4.0.3"
+
+func TestPhotoURLBuilder(t *testing.T) {
+	photo := unsplash.Photo{Urls: unsplash.Urls{Raw: rawURL}}
+
+	b, err := photo.URLBuilder()
+	require.Nil(t, err)
+
+	base := b.Fit(unsplash.FitCrop).Crop(unsplash.CropFaces, unsplash.CropEntropy).Quality(80)
+	u, err := url.Parse(base.Width(400).Height(300).Format(unsplash.FormatWebP).DPR(1.5).AutoFormat().Blur(50).String())
+	require.Nil(t, err)
+
+	assert.Equal(t, "images.unsplash.com", u.Host)
+	assert.Equal(t, "/photo-1536167038724-17be8c5e6876", u.Path)
+	assert.Equal(t, url.Values{
+		"ixid":  {"M3wxMjA3fDB8MXxzZWFyY2h8MXx8Y2FyfGVufDB8fHx8MTY5"},
+		"ixlib": {"rb-4.0.3"},
+		"fit":   {"crop"},
+		"crop":  {"faces,entropy"},
+		"q":     {"80"},
+		"w":     {"400"},
+		"h":     {"300"},
+		"fm":    {"webp"},
+		"dpr":   {"1.5"},
+		"auto":  {"format"},
+		"blur":  {"50"},
+	}, u.Query())
+
+	// setters do not modify the base builder
+	u, err = url.Parse(base.Width(1080).String())
+	require.Nil(t, err)
+	assert.Equal(t, "1080", u.Query().Get("w"))
+	assert.Equal(t, "", u.Query().Get("h"))
+	assert.Equal(t, "M3wxMjA3fDB8MXxzZWFyY2h8MXx8Y2FyfGVufDB8fHx8MTY5", u.Query().Get("ixid"))
+}
+
+func TestPhotoURLBuilder_Limits(t *testing.T) {
+	b, err := unsplash.NewPhotoURLBuilder(rawURL + "&auto=compress&w=100")
+	require.Nil(t, err)
+
+	u, err := url.Parse(b.Width(0).Quality(150).Blur(5000).DPR(-1).AutoFormat().AutoFormat().String())
+	require.Nil(t, err)
+
+	q := u.Query()
+	assert.Equal(t, "", q.Get("w"))
+	assert.Equal(t, "100", q.Get("q"))
+	assert.Equal(t, "2000", q.Get("blur"))
+	assert.Equal(t, "", q.Get("dpr"))
+	assert.Equal(t, "compress,format", q.Get("auto"))
+}
+
+func TestNewPhotoURLBuilder_Invalid(t *testing.T) {
+	for _, raw := range []string{"", "not a url", "://bad"} {
+		_, err := unsplash.NewPhotoURLBuilder(raw)
+		assert.True(t, errors.Is(err, unsplash.ErrBadRequest), raw)
+	}
+}
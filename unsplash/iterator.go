@@ -0,0 +1,188 @@
+package unsplash
+
+import "context"
+
+// Iterator walks paginated results lazily, fetching pages when needed.
+type Iterator[T any] struct {
+	fetch func(ctx context.Context, page int) ([]T, bool, *RateLimit, error)
+
+	page     int
+	perPage  int
+	maxItems int
+	seen     int
+	last     bool
+	err      error
+	rl       *RateLimit
+	items    []T
+	cur      T
+}
+
+// newIterator creates an iterator starting from page. fetch returns items of
+// the page and whether this page is the last one.
+func newIterator[T any](page, perPage, maxItems int, fetch func(ctx context.Context, page int) ([]T, bool, *RateLimit, error)) *Iterator[T] {
+	if page < 1 {
+		page = 1
+	}
+
+	if perPage == 0 {
+		perPage = 10
+	}
+
+	return &Iterator[T]{
+		fetch:    fetch,
+		page:     page - 1,
+		perPage:  perPage,
+		maxItems: maxItems,
+	}
+}
+
+// Next advances to the next item, fetching a new page when needed.
+func (it *Iterator[T]) Next(ctx context.Context) bool {
+	for len(it.items) == 0 {
+		if !it.load(ctx) {
+			return false
+		}
+	}
+
+	if it.capped() {
+		return false
+	}
+
+	it.seen++
+	it.cur, it.items = it.items[0], it.items[1:]
+
+	return true
+}
+
+// Item returns the current item.
+func (it *Iterator[T]) Item() T {
+	return it.cur
+}
+
+// Err returns the error which stopped iteration, if any.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// RateLimit returns rate limits of the last fetched page.
+func (it *Iterator[T]) RateLimit() *RateLimit {
+	return it.rl
+}
+
+// load fetches the next page. It returns false when there is nothing more to
+// read.
+func (it *Iterator[T]) load(ctx context.Context) bool {
+	if it.last || it.err != nil || it.capped() {
+		return false
+	}
+
+	it.page++
+
+	items, last, rl, err := it.fetch(ctx, it.page)
+	if rl != nil {
+		it.rl = rl
+	}
+
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	it.items = items
+	it.last = last || len(items) < it.perPage
+
+	return len(items) != 0
+}
+
+func (it *Iterator[T]) capped() bool {
+	return it.maxItems > 0 && it.seen >= it.maxItems
+}
+
+// isLast reports whether page is the last one according to the Link or
+// X-Total headers. It returns false when neither header is present.
+func (i *pageInfo) isLast(page, perPage int) bool {
+	if i.hasLink {
+		return !i.hasNext
+	}
+
+	if i.total >= 0 {
+		if i.perPage > 0 {
+			perPage = i.perPage
+		}
+
+		return page*perPage >= i.total
+	}
+
+	return false
+}
+
+// GetPhotosIterator iterates over GetPhotos results starting from opts.Page.
+// maxItems limits the number of returned photos; 0 means no limit.
+func (c *Client) GetPhotosIterator(opts GetPhotosOptions, maxItems int) *Iterator[Photo] {
+	return c.photoIterator("/photos", opts, maxItems)
+}
+
+// GetCuratedPhotosIterator iterates over GetCuratedPhotos results starting
+// from opts.Page. maxItems limits the number of returned photos; 0 means no
+// limit.
+func (c *Client) GetCuratedPhotosIterator(opts GetPhotosOptions, maxItems int) *Iterator[Photo] {
+	return c.photoIterator("/photos/curated", opts, maxItems)
+}
+
+func (c *Client) photoIterator(path string, opts GetPhotosOptions, maxItems int) *Iterator[Photo] {
+	var it *Iterator[Photo]
+	it = newIterator(opts.Page, opts.PerPage, maxItems, func(ctx context.Context, page int) ([]Photo, bool, *RateLimit, error) {
+		opts.Page = page
+		photos, info, rl, err := c.listPhotos(ctx, path, opts)
+		if err != nil {
+			return nil, false, rl, err
+		}
+
+		return photos, info.isLast(page, it.perPage), rl, nil
+	})
+
+	return it
+}
+
+// SearchPhotosIterator iterates over SearchPhotos results starting from
+// opts.Page. maxItems limits the number of returned photos; 0 means no limit.
+func (c *Client) SearchPhotosIterator(opts SearchPhotosOptions, maxItems int) *Iterator[SearchPhoto] {
+	return newIterator(opts.Page, opts.PerPage, maxItems, func(ctx context.Context, page int) ([]SearchPhoto, bool, *RateLimit, error) {
+		opts.Page = page
+		res, rl, err := c.SearchPhotos(ctx, opts)
+		if err != nil {
+			return nil, false, rl, err
+		}
+
+		return res.Results, page >= res.TotalPages, rl, nil
+	})
+}
+
+// SearchCollectionsIterator iterates over SearchCollections results starting
+// from opts.Page. maxItems limits the number of returned collections; 0 means
+// no limit.
+func (c *Client) SearchCollectionsIterator(opts SearchCollectionsOptions, maxItems int) *Iterator[Collection] {
+	return newIterator(opts.Page, opts.PerPage, maxItems, func(ctx context.Context, page int) ([]Collection, bool, *RateLimit, error) {
+		opts.Page = page
+		res, rl, err := c.SearchCollections(ctx, opts)
+		if err != nil {
+			return nil, false, rl, err
+		}
+
+		return res.Results, page >= res.TotalPages, rl, nil
+	})
+}
+
+// SearchUsersIterator iterates over SearchUsers results starting from
+// opts.Page. maxItems limits the number of returned users; 0 means no limit.
+func (c *Client) SearchUsersIterator(opts SearchUsersOptions, maxItems int) *Iterator[User] {
+	return newIterator(opts.Page, opts.PerPage, maxItems, func(ctx context.Context, page int) ([]User, bool, *RateLimit, error) {
+		opts.Page = page
+		res, rl, err := c.SearchUsers(ctx, opts)
+		if err != nil {
+			return nil, false, rl, err
+		}
+
+		return res.Results, page >= res.TotalPages, rl, nil
+	})
+}
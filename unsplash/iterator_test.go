@@ -0,0 +1,145 @@
+package unsplash_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/kazhuravlev/go-unsplash/unsplash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+// photoPages serves total photos split by per_page and counts requests.
+func photoPages(t *testing.T, total int, requests *int, headers func(w http.ResponseWriter, page int)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+
+		var photos []unsplash.Photo
+		for i := (page - 1) * perPage; i < page*perPage && i < total; i++ {
+			photos = append(photos, unsplash.Photo{ID: fmt.Sprintf("p%d", i)})
+		}
+
+		headers(w, page)
+		assert.Nil(t, json.NewEncoder(w).Encode(photos))
+	}
+}
+
+func TestGetPhotosIterator_Total(t *testing.T) {
+	var requests int
+	c, done := newTestClient(t, photoPages(t, 25, &requests, func(w http.ResponseWriter, page int) {
+		w.Header().Set("X-Total", "25")
+		w.Header().Set("X-Per-Page", "10")
+	}))
+	defer done()
+
+	it := c.GetPhotosIterator(unsplash.GetPhotosOptions{}, 0)
+
+	var ids []string
+	for it.Next(context.Background()) {
+		ids = append(ids, it.Item().ID)
+	}
+	require.Nil(t, it.Err())
+	assert.NotNil(t, it.RateLimit())
+	assert.Len(t, ids, 25)
+	assert.Equal(t, "p24", ids[24])
+	assert.Equal(t, 3, requests)
+}
+
+func TestGetPhotosIterator_Link(t *testing.T) {
+	var requests int
+	c, done := newTestClient(t, photoPages(t, 100, &requests, func(w http.ResponseWriter, page int) {
+		if page == 1 {
+			w.Header().Set("Link", `<https://api.unsplash.com/photos?page=2>; rel="next"`)
+		} else {
+			w.Header().Set("Link", `<https://api.unsplash.com/photos?page=1>; rel="first"`)
+		}
+	}))
+	defer done()
+
+	it := c.GetCuratedPhotosIterator(unsplash.GetPhotosOptions{PerPage: 5}, 0)
+
+	var n int
+	for it.Next(context.Background()) {
+		n++
+	}
+	require.Nil(t, it.Err())
+	assert.Equal(t, 10, n)
+	assert.Equal(t, 2, requests)
+}
+
+func TestGetPhotosIterator_MaxItems(t *testing.T) {
+	var requests int
+	c, done := newTestClient(t, photoPages(t, 100, &requests, func(w http.ResponseWriter, page int) {}))
+	defer done()
+
+	it := c.GetPhotosIterator(unsplash.GetPhotosOptions{}, 12)
+
+	var n int
+	for it.Next(context.Background()) {
+		n++
+	}
+	require.Nil(t, it.Err())
+	assert.Equal(t, 12, n)
+	assert.Equal(t, 2, requests)
+}
+
+func TestGetPhotosIterator_Error(t *testing.T) {
+	c, err := unsplash.New()
+	require.Nil(t, err)
+
+	it := c.GetPhotosIterator(unsplash.GetPhotosOptions{PerPage: 100}, 0)
+	assert.False(t, it.Next(context.Background()))
+	assert.Equal(t, unsplash.ErrBadRequest, it.Err())
+}
+
+func TestSearchPhotosIterator(t *testing.T) {
+	var requests int
+	c, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "car", r.URL.Query().Get("query"))
+		w.Write([]byte(`{"total":4,"total_pages":2,"results":[{"id":"a"},{"id":"b"}]}`))
+	})
+	defer done()
+
+	it := c.SearchPhotosIterator(unsplash.SearchPhotosOptions{Query: "car", PerPage: 2}, 0)
+
+	var n int
+	for it.Next(context.Background()) {
+		n++
+	}
+	require.Nil(t, it.Err())
+	assert.Equal(t, 4, n)
+	assert.Equal(t, 2, requests)
+}
+
+func TestSearchCollectionsIterator(t *testing.T) {
+	c, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"total":1,"total_pages":1,"results":[{"id":"1","title":"cars"}]}`))
+	})
+	defer done()
+
+	it := c.SearchCollectionsIterator(unsplash.SearchCollectionsOptions{Query: "car"}, 0)
+	require.True(t, it.Next(context.Background()))
+	assert.Equal(t, "cars", it.Item().Title)
+	assert.False(t, it.Next(context.Background()))
+	assert.Nil(t, it.Err())
+}
+
+func TestSearchUsersIterator(t *testing.T) {
+	c, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"total":1,"total_pages":1,"results":[{"username":"jdoe"}]}`))
+	})
+	defer done()
+
+	it := c.SearchUsersIterator(unsplash.SearchUsersOptions{Query: "jdoe"}, 0)
+	require.True(t, it.Next(context.Background()))
+	assert.Equal(t, "jdoe", it.Item().Username)
+	assert.False(t, it.Next(context.Background()))
+	assert.Nil(t, it.Err())
+}
@@ -0,0 +1,100 @@
+package unsplash
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// GetCurrentUser requires ScopeReadUser.
+func (c *Client) GetCurrentUser(ctx context.Context) (*CurrentUser, *RateLimit, error) {
+	if err := c.requireScope(ScopeReadUser); err != nil {
+		return nil, nil, err
+	}
+
+	var user CurrentUser
+	rl, err := c.do(ctx, http.MethodGet, "/me", nil, nil, http.StatusOK, &user)
+	if err != nil {
+		return nil, rl, err
+	}
+
+	return &user, rl, nil
+}
+
+type UpdateCurrentUserOptions struct {
+	Username          string
+	FirstName         string
+	LastName          string
+	Email             string
+	URL               string
+	Location          string
+	Bio               string
+	InstagramUsername string
+}
+
+func (o UpdateCurrentUserOptions) validate() error {
+	if o.URL != "" {
+		if _, err := url.ParseRequestURI(o.URL); err != nil {
+			return ErrBadRequest
+		}
+	}
+
+	return nil
+}
+
+func (o UpdateCurrentUserOptions) query() url.Values {
+	query := url.Values{}
+
+	if o.Username != "" {
+		query.Set("username", o.Username)
+	}
+
+	if o.FirstName != "" {
+		query.Set("first_name", o.FirstName)
+	}
+
+	if o.LastName != "" {
+		query.Set("last_name", o.LastName)
+	}
+
+	if o.Email != "" {
+		query.Set("email", o.Email)
+	}
+
+	if o.URL != "" {
+		query.Set("url", o.URL)
+	}
+
+	if o.Location != "" {
+		query.Set("location", o.Location)
+	}
+
+	if o.Bio != "" {
+		query.Set("bio", o.Bio)
+	}
+
+	if o.InstagramUsername != "" {
+		query.Set("instagram_username", o.InstagramUsername)
+	}
+
+	return query
+}
+
+// UpdateCurrentUser requires ScopeWriteUser.
+func (c *Client) UpdateCurrentUser(ctx context.Context, opts UpdateCurrentUserOptions) (*CurrentUser, *RateLimit, error) {
+	if err := c.requireScope(ScopeWriteUser); err != nil {
+		return nil, nil, err
+	}
+
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	var user CurrentUser
+	rl, err := c.do(ctx, http.MethodPut, "/me", opts.query(), nil, http.StatusOK, &user)
+	if err != nil {
+		return nil, rl, err
+	}
+
+	return &user, rl, nil
+}
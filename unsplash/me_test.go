@@ -0,0 +1,43 @@
+package unsplash_test
+
+import (
+	"context"
+	"github.com/kazhuravlev/go-unsplash/unsplash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"testing"
+)
+
+func TestGetCurrentUser(t *testing.T) {
+	c, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/me", r.URL.Path)
+		w.Write([]byte(`{"id":"1","username":"jdoe","email":"jdoe@example.com","uploads_remaining":7}`))
+	})
+	defer done()
+
+	user, _, err := c.GetCurrentUser(context.Background())
+	require.Nil(t, err)
+	assert.Equal(t, "jdoe", user.Username)
+	assert.Equal(t, "jdoe@example.com", user.Email)
+	assert.Equal(t, 7, user.UploadsRemaining)
+}
+
+func TestUpdateCurrentUser(t *testing.T) {
+	c, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/me", r.URL.Path)
+		assert.Equal(t, "Hello", r.URL.Query().Get("bio"))
+		assert.Equal(t, "", r.URL.Query().Get("email"))
+		w.Write([]byte(`{"id":"1","username":"jdoe","bio":"Hello"}`))
+	})
+	defer done()
+
+	user, _, err := c.UpdateCurrentUser(context.Background(), unsplash.UpdateCurrentUserOptions{Bio: "Hello"})
+	require.Nil(t, err)
+	assert.Equal(t, "Hello", user.Bio)
+
+	_, _, err = c.UpdateCurrentUser(context.Background(), unsplash.UpdateCurrentUserOptions{URL: "not a url"})
+	assert.Equal(t, unsplash.ErrBadRequest, err)
+}
@@ -2,7 +2,6 @@ package unsplash
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -85,30 +84,9 @@ func (c *Client) GetRandomPhotos(ctx context.Context, opts GetRandomPhotosOption
 		return nil, nil, err
 	}
 
-	u := apiURL + "/photos/random?" + opts.query().Encode()
-
-	req, err := http.NewRequest(http.MethodGet, u, nil)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	resp, err := c.httpClient.Do(req.WithContext(ctx))
-	if err != nil {
-		return nil, nil, err
-	}
-	defer resp.Body.Close()
-
-	rl, err := getLimits(resp)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, rl, handleError(resp)
-	}
-
 	var photos []Photo
-	if err := json.NewDecoder(resp.Body).Decode(&photos); err != nil {
+	rl, err := c.do(ctx, http.MethodGet, "/photos/random", opts.query(), nil, http.StatusOK, &photos)
+	if err != nil {
 		return nil, rl, err
 	}
 
@@ -121,6 +99,11 @@ const (
 	OrderByLatest  OrderBy = "latest"
 	OrderByOldest  OrderBy = "oldest"
 	OrderByPopular OrderBy = "popular"
+	// OrderByRelevant sorts search results by relevance.
+	OrderByRelevant OrderBy = "relevant"
+	// OrderByFeatured and OrderByPosition sort topics.
+	OrderByFeatured OrderBy = "featured"
+	OrderByPosition OrderBy = "position"
 )
 
 type GetPhotosOptions struct {
@@ -170,73 +153,33 @@ func (o GetPhotosOptions) query() url.Values {
 }
 
 func (c *Client) GetPhotos(ctx context.Context, opts GetPhotosOptions) ([]Photo, *RateLimit, error) {
-	if err := opts.validate(); err != nil {
-		return nil, nil, err
-	}
-
-	u := apiURL + "/photos?" + opts.query().Encode()
-
-	req, err := http.NewRequest(http.MethodGet, u, nil)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	resp, err := c.httpClient.Do(req.WithContext(ctx))
-	if err != nil {
-		return nil, nil, err
-	}
-	defer resp.Body.Close()
-
-	rl, err := getLimits(resp)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, rl, handleError(resp)
-	}
-
-	var photos []Photo
-	if err := json.NewDecoder(resp.Body).Decode(&photos); err != nil {
-		return nil, rl, err
-	}
-
-	return photos, rl, nil
+	photos, _, rl, err := c.listPhotos(ctx, "/photos", opts)
+	return photos, rl, err
 }
 
 func (c *Client) GetCuratedPhotos(ctx context.Context, opts GetPhotosOptions) ([]Photo, *RateLimit, error) {
-	if err := opts.validate(); err != nil {
-		return nil, nil, err
-	}
-
-	u := apiURL + "/photos/curated?" + opts.query().Encode()
-
-	req, err := http.NewRequest(http.MethodGet, u, nil)
-	if err != nil {
-		return nil, nil, err
-	}
+	photos, _, rl, err := c.listPhotos(ctx, "/photos/curated", opts)
+	return photos, rl, err
+}
 
-	resp, err := c.httpClient.Do(req.WithContext(ctx))
-	if err != nil {
-		return nil, nil, err
+// listPhotos fetches a page of photos from path along with pagination headers.
+func (c *Client) listPhotos(ctx context.Context, path string, opts GetPhotosOptions) ([]Photo, *pageInfo, *RateLimit, error) {
+	if err := opts.validate(); err != nil {
+		return nil, nil, nil, err
 	}
-	defer resp.Body.Close()
 
-	rl, err := getLimits(resp)
+	req, err := c.newRequest(http.MethodGet, path, opts.query(), nil)
 	if err != nil {
-		return nil, nil, err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, rl, handleError(resp)
+		return nil, nil, nil, err
 	}
 
 	var photos []Photo
-	if err := json.NewDecoder(resp.Body).Decode(&photos); err != nil {
-		return nil, rl, err
+	resp, rl, err := c.send(ctx, req, http.StatusOK, &photos)
+	if err != nil {
+		return nil, nil, rl, err
 	}
 
-	return photos, rl, nil
+	return photos, getPageInfo(resp), rl, nil
 }
 
 func (c *Client) GetPhoto(ctx context.Context, id string) (*Photo, *RateLimit, error) {
@@ -244,30 +187,9 @@ func (c *Client) GetPhoto(ctx context.Context, id string) (*Photo, *RateLimit, e
 		return nil, nil, ErrBadRequest
 	}
 
-	u := apiURL + "/photos/" + id
-
-	req, err := http.NewRequest(http.MethodGet, u, nil)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	resp, err := c.httpClient.Do(req.WithContext(ctx))
-	if err != nil {
-		return nil, nil, err
-	}
-	defer resp.Body.Close()
-
-	rl, err := getLimits(resp)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, rl, handleError(resp)
-	}
-
 	var photo Photo
-	if err := json.NewDecoder(resp.Body).Decode(&photo); err != nil {
+	rl, err := c.doCached(ctx, EndpointClassPhoto, "/photos/"+id, nil, &photo)
+	if err != nil {
 		return nil, rl, err
 	}
 
@@ -327,30 +249,9 @@ func (c *Client) GetPhotoStatistics(ctx context.Context, opts GetPhotoStatistics
 		return nil, nil, err
 	}
 
-	u := fmt.Sprintf("%s/photos/%s/statistics?%s", apiURL, opts.ID, opts.query().Encode())
-
-	req, err := http.NewRequest(http.MethodGet, u, nil)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	resp, err := c.httpClient.Do(req.WithContext(ctx))
-	if err != nil {
-		return nil, nil, err
-	}
-	defer resp.Body.Close()
-
-	rl, err := getLimits(resp)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, rl, handleError(resp)
-	}
-
 	var stat PhotoStatistics
-	if err := json.NewDecoder(resp.Body).Decode(&stat); err != nil {
+	rl, err := c.doCached(ctx, EndpointClassStatistics, fmt.Sprintf("/photos/%s/statistics", opts.ID), opts.query(), &stat)
+	if err != nil {
 		return nil, rl, err
 	}
 
@@ -362,30 +263,9 @@ func (c *Client) GetPhotoDownload(ctx context.Context, id string) (*PhotoDownloa
 		return nil, nil, ErrBadRequest
 	}
 
-	u := fmt.Sprintf("%s/photos/%s/download", apiURL, id)
-
-	req, err := http.NewRequest(http.MethodGet, u, nil)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	resp, err := c.httpClient.Do(req.WithContext(ctx))
-	if err != nil {
-		return nil, nil, err
-	}
-	defer resp.Body.Close()
-
-	rl, err := getLimits(resp)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, rl, handleError(resp)
-	}
-
 	var download PhotoDownload
-	if err := json.NewDecoder(resp.Body).Decode(&download); err != nil {
+	rl, err := c.do(ctx, http.MethodGet, fmt.Sprintf("/photos/%s/download", id), nil, nil, http.StatusOK, &download)
+	if err != nil {
 		return nil, rl, err
 	}
 
@@ -492,30 +372,11 @@ func (c *Client) UpdatePhoto(ctx context.Context, opts UpdatePhotoOptions) (*Pho
 		return nil, nil, err
 	}
 
-	u := fmt.Sprintf("%s/photos/%s?%s", apiURL, opts.ID, opts.query().Encode())
-
-	req, err := http.NewRequest(http.MethodPut, u, nil)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	resp, err := c.httpClient.Do(req.WithContext(ctx))
-	if err != nil {
-		return nil, nil, err
-	}
-	defer resp.Body.Close()
+	var photo Photo
+	rl, err := c.do(ctx, http.MethodPut, fmt.Sprintf("/photos/%s", opts.ID), opts.query(), nil, http.StatusOK, &photo)
+	c.invalidatePhoto(opts.ID)
 
-	rl, err := getLimits(resp)
 	if err != nil {
-		return nil, nil, err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, rl, handleError(resp)
-	}
-
-	var photo Photo
-	if err := json.NewDecoder(resp.Body).Decode(&photo); err != nil {
 		return nil, rl, err
 	}
 
@@ -527,30 +388,11 @@ func (c *Client) LikePhoto(ctx context.Context, id string) (*Photo, *RateLimit,
 		return nil, nil, ErrBadRequest
 	}
 
-	u := fmt.Sprintf("%s/photos/%s/like", apiURL, id)
-
-	req, err := http.NewRequest(http.MethodPost, u, nil)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	resp, err := c.httpClient.Do(req.WithContext(ctx))
-	if err != nil {
-		return nil, nil, err
-	}
-	defer resp.Body.Close()
+	var photo Photo
+	rl, err := c.do(ctx, http.MethodPost, fmt.Sprintf("/photos/%s/like", id), nil, nil, http.StatusCreated, &photo)
+	c.invalidatePhoto(id)
 
-	rl, err := getLimits(resp)
 	if err != nil {
-		return nil, nil, err
-	}
-
-	if resp.StatusCode != http.StatusCreated {
-		return nil, rl, handleError(resp)
-	}
-
-	var photo Photo
-	if err := json.NewDecoder(resp.Body).Decode(&photo); err != nil {
 		return nil, rl, err
 	}
 
@@ -562,30 +404,11 @@ func (c *Client) UnlikePhoto(ctx context.Context, id string) (*Photo, *RateLimit
 		return nil, nil, ErrBadRequest
 	}
 
-	u := fmt.Sprintf("%s/photos/%s/like", apiURL, id)
-
-	req, err := http.NewRequest(http.MethodDelete, u, nil)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	resp, err := c.httpClient.Do(req.WithContext(ctx))
-	if err != nil {
-		return nil, nil, err
-	}
-	defer resp.Body.Close()
+	var photo Photo
+	rl, err := c.do(ctx, http.MethodDelete, fmt.Sprintf("/photos/%s/like", id), nil, nil, http.StatusOK, &photo)
+	c.invalidatePhoto(id)
 
-	rl, err := getLimits(resp)
 	if err != nil {
-		return nil, nil, err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, rl, handleError(resp)
-	}
-
-	var photo Photo
-	if err := json.NewDecoder(resp.Body).Decode(&photo); err != nil {
 		return nil, rl, err
 	}
 
@@ -603,9 +426,21 @@ type SearchPhotosOptions struct {
 	Collections []string
 	// Orientation Filter search results by photo orientation. Valid values are landscape, portrait, and squarish.
 	Orientation Orientation
+	// OrderBy How to sort the photos. Valid values are relevant and latest. (Optional; default: relevant)
+	OrderBy OrderBy
+	// Color Filter results by color. (Optional)
+	Color ColorFilter
+	// ContentFilter Limit results by content safety. (Optional; default: low)
+	ContentFilter ContentFilter
+	// Lang Language of the query as ISO 639-1 code. (Optional; default: en)
+	Lang Lang
 }
 
 func (o SearchPhotosOptions) validate() error {
+	if o.Query == "" {
+		return ErrBadRequest
+	}
+
 	if o.Page < 0 {
 		return ErrBadRequest
 	}
@@ -624,7 +459,23 @@ func (o SearchPhotosOptions) validate() error {
 		return ErrBadRequest
 	}
 
-	if o.Query != "" && len(o.Collections) != 0 {
+	switch o.OrderBy {
+	case "", OrderByRelevant, OrderByLatest:
+	default:
+		return ErrBadRequest
+	}
+
+	if o.Color != "" && !o.Color.valid() {
+		return ErrBadRequest
+	}
+
+	switch o.ContentFilter {
+	case "", ContentFilterLow, ContentFilterHigh:
+	default:
+		return ErrBadRequest
+	}
+
+	if o.Lang != "" && !o.Lang.valid() {
 		return ErrBadRequest
 	}
 
@@ -653,6 +504,22 @@ func (o SearchPhotosOptions) query() url.Values {
 		query.Set("orientation", string(o.Orientation))
 	}
 
+	if o.OrderBy != "" {
+		query.Set("order_by", string(o.OrderBy))
+	}
+
+	if o.Color != "" {
+		query.Set("color", string(o.Color))
+	}
+
+	if o.ContentFilter != "" {
+		query.Set("content_filter", string(o.ContentFilter))
+	}
+
+	if o.Lang != "" {
+		query.Set("lang", string(o.Lang))
+	}
+
 	query.Set("page", strconv.Itoa(o.Page))
 	query.Set("per_page", strconv.Itoa(o.PerPage))
 
@@ -664,30 +531,9 @@ func (c *Client) SearchPhotos(ctx context.Context, opts SearchPhotosOptions) (*S
 		return nil, nil, err
 	}
 
-	u := fmt.Sprintf("%s/search/photos?%s", apiURL, opts.query().Encode())
-
-	req, err := http.NewRequest(http.MethodGet, u, nil)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	resp, err := c.httpClient.Do(req.WithContext(ctx))
-	if err != nil {
-		return nil, nil, err
-	}
-	defer resp.Body.Close()
-
-	rl, err := getLimits(resp)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, rl, handleError(resp)
-	}
-
 	var searchRes SearchResult
-	if err := json.NewDecoder(resp.Body).Decode(&searchRes); err != nil {
+	rl, err := c.doCached(ctx, EndpointClassSearch, "/search/photos", opts.query(), &searchRes)
+	if err != nil {
 		return nil, rl, err
 	}
 
@@ -741,30 +587,9 @@ func (c *Client) SearchCollections(ctx context.Context, opts SearchCollectionsOp
 		return nil, nil, err
 	}
 
-	u := fmt.Sprintf("%s/search/collections?%s", apiURL, opts.query().Encode())
-
-	req, err := http.NewRequest(http.MethodGet, u, nil)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	resp, err := c.httpClient.Do(req.WithContext(ctx))
-	if err != nil {
-		return nil, nil, err
-	}
-	defer resp.Body.Close()
-
-	rl, err := getLimits(resp)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, rl, handleError(resp)
-	}
-
 	var searchRes CollectionSearchResult
-	if err := json.NewDecoder(resp.Body).Decode(&searchRes); err != nil {
+	rl, err := c.doCached(ctx, EndpointClassSearch, "/search/collections", opts.query(), &searchRes)
+	if err != nil {
 		return nil, rl, err
 	}
 
@@ -818,33 +643,9 @@ func (c *Client) SearchUsers(ctx context.Context, opts SearchUsersOptions) (*Use
 		return nil, nil, err
 	}
 
-	u := fmt.Sprintf("%s/search/users?%s", apiURL, opts.query().Encode())
-
-	req, err := http.NewRequest(http.MethodGet, u, nil)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	resp, err := c.httpClient.Do(req.WithContext(ctx))
-	if err != nil {
-		return nil, nil, err
-	}
-	defer resp.Body.Close()
-
-	//ss, _ := ioutil.ReadAll(resp.Body)
-	//fmt.Println(string(ss))
-
-	rl, err := getLimits(resp)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, rl, handleError(resp)
-	}
-
 	var searchRes UsersSearchResult
-	if err := json.NewDecoder(resp.Body).Decode(&searchRes); err != nil {
+	rl, err := c.doCached(ctx, EndpointClassSearch, "/search/users", opts.query(), &searchRes)
+	if err != nil {
 		return nil, rl, err
 	}
 
@@ -14,7 +14,7 @@ const (
 )
 
 func TestClient_GetRandomPhotos(t *testing.T) {
-	c, err := unsplash.New(unsplash.WithHttpClient(httpClient))
+	c, err := unsplash.New(unsplash.WithHttpClient(testHTTPClient(t)))
 	require.Nil(t, err)
 
 	n := 30
@@ -32,7 +32,7 @@ func TestClient_GetRandomPhotos(t *testing.T) {
 }
 
 func TestClient_GetPhotos(t *testing.T) {
-	c, err := unsplash.New(unsplash.WithHttpClient(httpClient))
+	c, err := unsplash.New(unsplash.WithHttpClient(testHTTPClient(t)))
 	require.Nil(t, err)
 
 	n := 30
@@ -49,7 +49,7 @@ func TestClient_GetPhotos(t *testing.T) {
 }
 
 func TestClient_GetCuratedPhotos(t *testing.T) {
-	c, err := unsplash.New(unsplash.WithHttpClient(httpClient))
+	c, err := unsplash.New(unsplash.WithHttpClient(testHTTPClient(t)))
 	require.Nil(t, err)
 
 	n := 30
@@ -66,7 +66,7 @@ func TestClient_GetCuratedPhotos(t *testing.T) {
 }
 
 func TestClient_GetPhoto(t *testing.T) {
-	c, err := unsplash.New(unsplash.WithHttpClient(httpClient))
+	c, err := unsplash.New(unsplash.WithHttpClient(testHTTPClient(t)))
 	require.Nil(t, err)
 
 	id := "pnNR3P5m15s"
@@ -82,7 +82,7 @@ func TestClient_GetPhoto(t *testing.T) {
 }
 
 func TestClient_GetPhotoStatistics(t *testing.T) {
-	c, err := unsplash.New(unsplash.WithHttpClient(httpClient))
+	c, err := unsplash.New(unsplash.WithHttpClient(testHTTPClient(t)))
 	require.Nil(t, err)
 
 	id := "Mg0W1N_yDv0"
@@ -97,7 +97,7 @@ func TestClient_GetPhotoStatistics(t *testing.T) {
 }
 
 func TestClient_GetPhotoDownload(t *testing.T) {
-	c, err := unsplash.New(unsplash.WithHttpClient(httpClient))
+	c, err := unsplash.New(unsplash.WithHttpClient(testHTTPClient(t)))
 	require.Nil(t, err)
 
 	id := "Mg0W1N_yDv0"
@@ -112,7 +112,7 @@ func TestClient_GetPhotoDownload(t *testing.T) {
 }
 
 func TestClient_UpdatePhoto(t *testing.T) {
-	c, err := unsplash.New(unsplash.WithHttpClient(httpClient))
+	c, err := unsplash.New(unsplash.WithHttpClient(testHTTPClient(t)))
 	require.Nil(t, err)
 
 	id := "pnNR3P5m15s"
@@ -127,7 +127,7 @@ func TestClient_UpdatePhoto(t *testing.T) {
 }
 
 func TestClient_LikePhoto(t *testing.T) {
-	c, err := unsplash.New(unsplash.WithHttpClient(httpClient))
+	c, err := unsplash.New(unsplash.WithHttpClient(testHTTPClient(t)))
 	require.Nil(t, err)
 
 	id := "pnNR3P5m15s"
@@ -142,7 +142,7 @@ func TestClient_LikePhoto(t *testing.T) {
 }
 
 func TestClient_UnlikePhoto(t *testing.T) {
-	c, err := unsplash.New(unsplash.WithHttpClient(httpClient))
+	c, err := unsplash.New(unsplash.WithHttpClient(testHTTPClient(t)))
 	require.Nil(t, err)
 
 	id := "pnNR3P5m15s"
@@ -157,7 +157,7 @@ func TestClient_UnlikePhoto(t *testing.T) {
 }
 
 func TestClient_SearchPhotos(t *testing.T) {
-	c, err := unsplash.New(unsplash.WithHttpClient(httpClient))
+	c, err := unsplash.New(unsplash.WithHttpClient(testHTTPClient(t)))
 	require.Nil(t, err)
 
 	res, rl, err := c.SearchPhotos(context.Background(), unsplash.SearchPhotosOptions{Query: "car"})
@@ -173,7 +173,7 @@ func TestClient_SearchPhotos(t *testing.T) {
 }
 
 func TestClient_SearchCollections(t *testing.T) {
-	c, err := unsplash.New(unsplash.WithHttpClient(httpClient))
+	c, err := unsplash.New(unsplash.WithHttpClient(testHTTPClient(t)))
 	require.Nil(t, err)
 
 	res, rl, err := c.SearchCollections(context.Background(), unsplash.SearchCollectionsOptions{Query: "car"})
@@ -189,7 +189,7 @@ func TestClient_SearchCollections(t *testing.T) {
 }
 
 func TestClient_SearchUsers(t *testing.T) {
-	c, err := unsplash.New(unsplash.WithHttpClient(httpClient))
+	c, err := unsplash.New(unsplash.WithHttpClient(testHTTPClient(t)))
 	require.Nil(t, err)
 
 	res, rl, err := c.SearchUsers(context.Background(), unsplash.SearchUsersOptions{Query: "car"})
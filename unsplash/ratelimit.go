@@ -0,0 +1,152 @@
+package unsplash
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// rateLimitWindow is the period after which the API replenishes the quota.
+const rateLimitWindow = time.Hour
+
+// RateLimitPolicy defines what the client does before sending a request when
+// the quota observed in previous responses is exhausted.
+type RateLimitPolicy int
+
+const (
+	// RateLimitPolicyNone only tracks rate limits. Default.
+	RateLimitPolicyNone RateLimitPolicy = iota
+	// RateLimitPolicyWait blocks until the hour window resets or the request
+	// context is done.
+	RateLimitPolicyWait
+	// RateLimitPolicyFailFast returns *RateLimitError without sending the
+	// request.
+	RateLimitPolicyFailFast
+)
+
+// RateLimitError is returned by RateLimitPolicyFailFast when the quota is
+// exhausted. It matches ErrRateLimited with errors.Is.
+type RateLimitError struct {
+	RateLimit RateLimit
+	// ResetAt is the estimated time when the quota will be replenished.
+	ResetAt time.Time
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("unsplash: rate limit %d exceeded, resets at %s", e.RateLimit.Limit, e.ResetAt.Format(time.RFC3339))
+}
+
+func (e *RateLimitError) Is(target error) bool {
+	return target == ErrRateLimited
+}
+
+// rateLimiter tracks the last observed rate limits and applies the policy.
+type rateLimiter struct {
+	policy RateLimitPolicy
+	now    func() time.Time
+
+	mu sync.Mutex
+	// last holds the observed limits minus requests sent since then.
+	last *RateLimit
+	// reported holds the limits of the last response as the server sent them.
+	reported *RateLimit
+	resetAt  time.Time
+}
+
+func newRateLimiter(policy RateLimitPolicy) *rateLimiter {
+	return &rateLimiter{
+		policy: policy,
+		now:    time.Now,
+	}
+}
+
+// observe updates limits from response headers. The window is assumed to
+// start when limits are seen for the first time, when the previous window is
+// over or when the server reports more remaining requests than before.
+func (l *rateLimiter) observe(resp *http.Response) {
+	rl, err := getLimits(resp)
+	if err != nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if l.reported == nil || rl.Remaining > l.reported.Remaining || now.After(l.resetAt) {
+		l.resetAt = now.Add(rateLimitWindow)
+	}
+
+	reported := *rl
+	l.reported = &reported
+	l.last = rl
+}
+
+func (l *rateLimiter) rateLimit() *RateLimit {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.last == nil {
+		return nil
+	}
+
+	rl := *l.last
+
+	return &rl
+}
+
+// wait applies the policy before sending a request and reserves one request
+// from the remaining quota.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		if l.last == nil || l.last.Remaining > 0 || !l.now().Before(l.resetAt) {
+			if l.last != nil && l.last.Remaining > 0 {
+				l.last.Remaining--
+			}
+			l.mu.Unlock()
+			return nil
+		}
+
+		rl, resetAt := *l.last, l.resetAt
+		l.mu.Unlock()
+
+		switch l.policy {
+		case RateLimitPolicyWait:
+		case RateLimitPolicyFailFast:
+			return &RateLimitError{RateLimit: rl, ResetAt: resetAt}
+		default:
+			return nil
+		}
+
+		timer := time.NewTimer(resetAt.Sub(l.now()))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// WithRateLimitPolicy sets the behaviour when the quota is exhausted.
+func WithRateLimitPolicy(policy RateLimitPolicy) Option {
+	return func(c *Client) error {
+		switch policy {
+		case RateLimitPolicyNone, RateLimitPolicyWait, RateLimitPolicyFailFast:
+		default:
+			return ErrBadRequest
+		}
+
+		c.limiter.policy = policy
+		return nil
+	}
+}
+
+// RateLimit returns the last observed rate limits minus requests sent since
+// then, or nil when no response was received yet.
+func (c *Client) RateLimit() *RateLimit {
+	return c.limiter.rateLimit()
+}
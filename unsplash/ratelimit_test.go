@@ -0,0 +1,150 @@
+package unsplash_test
+
+import (
+	"context"
+	"errors"
+	"github.com/kazhuravlev/go-unsplash/unsplash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// newQuotaServer serves photos and reports remaining quota which decreases
+// with every request.
+func newQuotaServer(remaining int, requests *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		remaining--
+		w.Header().Set("X-Ratelimit-Limit", "50")
+		w.Header().Set("X-Ratelimit-Remaining", strconv.Itoa(remaining))
+		w.Write([]byte(`{"id":"abc"}`))
+	}))
+}
+
+func TestRateLimit(t *testing.T) {
+	var requests int
+	srv := newQuotaServer(10, &requests)
+	defer srv.Close()
+
+	c, err := unsplash.New(unsplash.WithBaseURL(srv.URL))
+	require.Nil(t, err)
+	assert.Nil(t, c.RateLimit())
+
+	_, _, err = c.GetPhoto(context.Background(), "abc")
+	require.Nil(t, err)
+
+	rl := c.RateLimit()
+	require.NotNil(t, rl)
+	assert.Equal(t, 50, rl.Limit)
+	assert.Equal(t, 9, rl.Remaining)
+}
+
+func TestRateLimitPolicyFailFast(t *testing.T) {
+	var requests int
+	srv := newQuotaServer(2, &requests)
+	defer srv.Close()
+
+	c, err := unsplash.New(unsplash.WithBaseURL(srv.URL), unsplash.WithRateLimitPolicy(unsplash.RateLimitPolicyFailFast))
+	require.Nil(t, err)
+
+	for i := 0; i < 2; i++ {
+		_, _, err = c.GetPhoto(context.Background(), "abc")
+		require.Nil(t, err)
+	}
+
+	_, _, err = c.GetPhoto(context.Background(), "abc")
+	assert.True(t, errors.Is(err, unsplash.ErrRateLimited))
+
+	var rlErr *unsplash.RateLimitError
+	require.True(t, errors.As(err, &rlErr))
+	assert.Equal(t, 0, rlErr.RateLimit.Remaining)
+	assert.True(t, rlErr.ResetAt.After(time.Now()))
+	assert.Equal(t, 2, requests)
+}
+
+func TestRateLimitPolicyWait(t *testing.T) {
+	var requests int
+	srv := newQuotaServer(1, &requests)
+	defer srv.Close()
+
+	c, err := unsplash.New(unsplash.WithBaseURL(srv.URL), unsplash.WithRateLimitPolicy(unsplash.RateLimitPolicyWait))
+	require.Nil(t, err)
+
+	_, _, err = c.GetPhoto(context.Background(), "abc")
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, _, err = c.GetPhoto(ctx, "abc")
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Equal(t, 1, requests)
+}
+
+func TestWithRateLimitPolicy_Invalid(t *testing.T) {
+	_, err := unsplash.New(unsplash.WithRateLimitPolicy(unsplash.RateLimitPolicy(42)))
+	assert.Equal(t, unsplash.ErrBadRequest, err)
+}
+
+func TestRateLimit_ConcurrentKeepsWindow(t *testing.T) {
+	var mu sync.Mutex
+	remaining := 3
+	arrived := 0
+	bothArrived := make(chan struct{})
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		remaining--
+		rem := remaining
+		arrived++
+		if arrived == 3 {
+			close(bothArrived)
+		}
+		mu.Unlock()
+
+		switch rem {
+		case 1:
+			<-bothArrived
+		case 0:
+			<-release
+		}
+
+		w.Header().Set("X-Ratelimit-Limit", "50")
+		w.Header().Set("X-Ratelimit-Remaining", strconv.Itoa(rem))
+		w.Write([]byte(`{"id":"abc"}`))
+	}))
+	defer srv.Close()
+
+	c, err := unsplash.New(unsplash.WithBaseURL(srv.URL), unsplash.WithRateLimitPolicy(unsplash.RateLimitPolicyFailFast))
+	require.Nil(t, err)
+
+	ctx := context.Background()
+	_, _, err = c.GetPhoto(ctx, "abc")
+	require.Nil(t, err)
+	windowEnd := time.Now().Add(time.Hour)
+	time.Sleep(10 * time.Millisecond)
+
+	// both requests are sent before either response is observed, so the
+	// local counter drops below the remaining count of the first response
+	results := make(chan error)
+	for i := 0; i < 2; i++ {
+		go func() {
+			_, _, err := c.GetPhoto(ctx, "abc")
+			results <- err
+		}()
+	}
+
+	require.Nil(t, <-results)
+	close(release)
+	require.Nil(t, <-results)
+
+	_, _, err = c.GetPhoto(ctx, "abc")
+	var rlErr *unsplash.RateLimitError
+	require.True(t, errors.As(err, &rlErr))
+	assert.False(t, rlErr.ResetAt.After(windowEnd))
+}
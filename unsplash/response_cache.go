@@ -0,0 +1,201 @@
+package unsplash
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// EndpointClass groups endpoints which share response cache TTL.
+type EndpointClass string
+
+const (
+	// EndpointClassPhoto is GetPhoto.
+	EndpointClassPhoto EndpointClass = "photo"
+	// EndpointClassStatistics is GetPhotoStatistics.
+	EndpointClassStatistics EndpointClass = "statistics"
+	// EndpointClassSearch is SearchPhotos, SearchCollections and SearchUsers.
+	EndpointClassSearch EndpointClass = "search"
+	// EndpointClassCollection is GetCollection and ListCollectionPhotos.
+	EndpointClassCollection EndpointClass = "collection"
+)
+
+// WithResponseCache memoizes response bodies in process for the given TTL
+// per endpoint class. Classes without TTL are not cached. Cache hits do not
+// send requests, return the last observed rate limits and are decoded anew,
+// so callers may modify results.
+//
+// LikePhoto, UnlikePhoto and UpdatePhoto invalidate entries of the photo.
+// Collection write methods invalidate entries of the collection, and adding
+// or removing a photo also invalidates the photo. Every write invalidates all
+// search entries, which may contain the changed object.
+func WithResponseCache(ttl map[EndpointClass]time.Duration) Option {
+	return func(c *Client) error {
+		copied := make(map[EndpointClass]time.Duration, len(ttl))
+		for class, d := range ttl {
+			if d < 0 {
+				return ErrBadRequest
+			}
+
+			copied[class] = d
+		}
+
+		c.responses = &responseCache{
+			ttl:     copied,
+			now:     time.Now,
+			entries: make(map[string]responseCacheEntry),
+		}
+		return nil
+	}
+}
+
+type responseCache struct {
+	ttl map[EndpointClass]time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]responseCacheEntry
+	// gen is incremented by every invalidation.
+	gen uint64
+}
+
+type responseCacheEntry struct {
+	class   EndpointClass
+	body    []byte
+	expires time.Time
+}
+
+// load returns the cached body of key.
+func (r *responseCache) load(key string) ([]byte, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry, ok := r.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	if !r.now().Before(entry.expires) {
+		delete(r.entries, key)
+		return nil, false
+	}
+
+	return entry.body, true
+}
+
+// generation returns the current invalidation generation.
+func (r *responseCache) generation() uint64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.gen
+}
+
+// store saves body unless an invalidation happened since gen was read, in
+// which case body may be stale.
+func (r *responseCache) store(class EndpointClass, key string, body []byte, gen uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.gen != gen {
+		return
+	}
+
+	now := r.now()
+	for k, entry := range r.entries {
+		if !now.Before(entry.expires) {
+			delete(r.entries, k)
+		}
+	}
+
+	r.entries[key] = responseCacheEntry{
+		class:   class,
+		body:    body,
+		expires: now.Add(r.ttl[class]),
+	}
+}
+
+// invalidate removes entries of path and its sub-resources.
+func (r *responseCache) invalidate(path string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.gen++
+	for k := range r.entries {
+		if k == path || strings.HasPrefix(k, path+"/") || strings.HasPrefix(k, path+"?") {
+			delete(r.entries, k)
+		}
+	}
+}
+
+// invalidateClass removes all entries of the class.
+func (r *responseCache) invalidateClass(class EndpointClass) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.gen++
+	for k, entry := range r.entries {
+		if entry.class == class {
+			delete(r.entries, k)
+		}
+	}
+}
+
+// doCached is a GET request through the response cache.
+func (c *Client) doCached(ctx context.Context, class EndpointClass, path string, query url.Values, out interface{}) (*RateLimit, error) {
+	if c.responses == nil || c.responses.ttl[class] == 0 {
+		return c.do(ctx, http.MethodGet, path, query, nil, http.StatusOK, out)
+	}
+
+	key := path
+	if len(query) != 0 {
+		key += "?" + query.Encode()
+	}
+
+	if body, ok := c.responses.load(key); ok {
+		return c.RateLimit(), json.Unmarshal(body, out)
+	}
+
+	gen := c.responses.generation()
+
+	var body json.RawMessage
+	rl, err := c.do(ctx, http.MethodGet, path, query, nil, http.StatusOK, &body)
+	if err != nil {
+		return rl, err
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return rl, err
+	}
+
+	c.responses.store(class, key, body, gen)
+
+	return rl, nil
+}
+
+func (c *Client) invalidatePhoto(id string) {
+	if c.responses == nil {
+		return
+	}
+
+	c.responses.invalidate("/photos/" + id)
+	c.responses.invalidateClass(EndpointClassSearch)
+}
+
+// invalidateCollection drops entries of the collection and, when photoID is
+// set, of the photo added to or removed from it.
+func (c *Client) invalidateCollection(id, photoID string) {
+	if c.responses == nil {
+		return
+	}
+
+	c.responses.invalidate("/collections/" + id)
+	if photoID != "" {
+		c.responses.invalidate("/photos/" + photoID)
+	}
+	c.responses.invalidateClass(EndpointClassSearch)
+}
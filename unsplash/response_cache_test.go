@@ -0,0 +1,219 @@
+package unsplash_test
+
+import (
+	"context"
+	"github.com/kazhuravlev/go-unsplash/unsplash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWithResponseCache(t *testing.T) {
+	requests := map[string]int{}
+	c, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests[r.Method+" "+r.URL.Path]++
+		switch r.URL.Path {
+		case "/search/photos":
+			w.Write([]byte(`{"total":1,"total_pages":1,"results":[{"id":"abc"}]}`))
+		case "/photos/abc/like":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":"abc","liked_by_user":true}`))
+		default:
+			w.Write([]byte(`{"id":"abc"}`))
+		}
+	}, unsplash.WithResponseCache(map[unsplash.EndpointClass]time.Duration{
+		unsplash.EndpointClassPhoto:  time.Minute,
+		unsplash.EndpointClassSearch: time.Minute,
+	}))
+	defer done()
+
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		res, _, err := c.SearchPhotos(ctx, unsplash.SearchPhotosOptions{Query: "car"})
+		require.Nil(t, err)
+		assert.Equal(t, "abc", res.Results[0].ID)
+
+		photo, rl, err := c.GetPhoto(ctx, "abc")
+		require.Nil(t, err)
+		assert.Equal(t, "abc", photo.ID)
+		assert.NotNil(t, rl)
+	}
+
+	assert.Equal(t, 1, requests["GET /search/photos"])
+	assert.Equal(t, 1, requests["GET /photos/abc"])
+
+	_, _, err := c.SearchPhotos(ctx, unsplash.SearchPhotosOptions{Query: "dog"})
+	require.Nil(t, err)
+	assert.Equal(t, 2, requests["GET /search/photos"])
+
+	// statistics class has no TTL
+	for i := 0; i < 2; i++ {
+		_, _, err = c.GetPhotoStatistics(ctx, unsplash.GetPhotoStatisticsOptions{ID: "abc"})
+		require.Nil(t, err)
+	}
+	assert.Equal(t, 2, requests["GET /photos/abc/statistics"])
+
+	_, _, err = c.LikePhoto(ctx, "abc")
+	require.Nil(t, err)
+
+	_, _, err = c.GetPhoto(ctx, "abc")
+	require.Nil(t, err)
+	assert.Equal(t, 2, requests["GET /photos/abc"])
+
+	// search results may contain the liked photo
+	_, _, err = c.SearchPhotos(ctx, unsplash.SearchPhotosOptions{Query: "car"})
+	require.Nil(t, err)
+	assert.Equal(t, 3, requests["GET /search/photos"])
+}
+
+func TestWithResponseCache_CopiesTTL(t *testing.T) {
+	requests := 0
+	ttl := map[unsplash.EndpointClass]time.Duration{unsplash.EndpointClassPhoto: time.Minute}
+	c, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"id":"abc"}`))
+	}, unsplash.WithResponseCache(ttl))
+	defer done()
+
+	delete(ttl, unsplash.EndpointClassPhoto)
+
+	for i := 0; i < 2; i++ {
+		_, _, err := c.GetPhoto(context.Background(), "abc")
+		require.Nil(t, err)
+	}
+	assert.Equal(t, 1, requests)
+}
+
+func TestWithResponseCache_Invalid(t *testing.T) {
+	_, err := unsplash.New(unsplash.WithResponseCache(map[unsplash.EndpointClass]time.Duration{
+		unsplash.EndpointClassPhoto: -time.Second,
+	}))
+	assert.Equal(t, unsplash.ErrBadRequest, err)
+}
+
+func TestWithResponseCache_ReturnsCopies(t *testing.T) {
+	c, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"total":1,"total_pages":1,"results":[{"id":"abc"}]}`))
+	}, unsplash.WithResponseCache(map[unsplash.EndpointClass]time.Duration{
+		unsplash.EndpointClassSearch: time.Minute,
+	}))
+	defer done()
+
+	ctx := context.Background()
+	res, _, err := c.SearchPhotos(ctx, unsplash.SearchPhotosOptions{Query: "car"})
+	require.Nil(t, err)
+	res.Results[0].ID = "changed"
+	res.Results = append(res.Results, unsplash.SearchPhoto{})
+
+	res, _, err = c.SearchPhotos(ctx, unsplash.SearchPhotosOptions{Query: "car"})
+	require.Nil(t, err)
+	require.Len(t, res.Results, 1)
+	assert.Equal(t, "abc", res.Results[0].ID)
+}
+
+func TestWithResponseCache_InvalidatedInFlight(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var mu sync.Mutex
+	requests := 0
+	c, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":"abc","liked_by_user":true}`))
+			return
+		}
+
+		mu.Lock()
+		requests++
+		first := requests == 1
+		mu.Unlock()
+
+		if first {
+			close(started)
+			<-release
+		}
+		w.Write([]byte(`{"id":"abc"}`))
+	}, unsplash.WithResponseCache(map[unsplash.EndpointClass]time.Duration{
+		unsplash.EndpointClassPhoto: time.Minute,
+	}))
+	defer done()
+
+	ctx := context.Background()
+	fetched := make(chan error)
+	go func() {
+		_, _, err := c.GetPhoto(ctx, "abc")
+		fetched <- err
+	}()
+
+	<-started
+	_, _, err := c.LikePhoto(ctx, "abc")
+	require.Nil(t, err)
+	close(release)
+	require.Nil(t, <-fetched)
+
+	// the response of the request sent before LikePhoto is not cached
+	_, _, err = c.GetPhoto(ctx, "abc")
+	require.Nil(t, err)
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, 2, requests)
+}
+
+func TestWithResponseCache_CollectionWrites(t *testing.T) {
+	requests := map[string]int{}
+	c, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests[r.Method+" "+r.URL.Path]++
+		switch r.URL.Path {
+		case "/collections/c1/photos":
+			w.Write([]byte(`[{"id":"abc"}]`))
+		case "/collections/c1/add":
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"photo":{"id":"abc"},"collection":{"id":"c1"}}`))
+		case "/collections/c1":
+			if r.Method == http.MethodDelete {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			w.Write([]byte(`{"id":"c1"}`))
+		default:
+			w.Write([]byte(`{"id":"abc"}`))
+		}
+	}, unsplash.WithResponseCache(map[unsplash.EndpointClass]time.Duration{
+		unsplash.EndpointClassPhoto:      time.Minute,
+		unsplash.EndpointClassCollection: time.Minute,
+	}))
+	defer done()
+
+	ctx := context.Background()
+	fetch := func() {
+		_, _, err := c.GetCollection(ctx, "c1")
+		require.Nil(t, err)
+		_, _, err = c.ListCollectionPhotos(ctx, unsplash.ListCollectionPhotosOptions{ID: "c1"})
+		require.Nil(t, err)
+		_, _, err = c.GetPhoto(ctx, "abc")
+		require.Nil(t, err)
+	}
+
+	fetch()
+	fetch()
+	assert.Equal(t, 1, requests["GET /collections/c1"])
+	assert.Equal(t, 1, requests["GET /collections/c1/photos"])
+	assert.Equal(t, 1, requests["GET /photos/abc"])
+
+	_, _, err := c.AddPhotoToCollection(ctx, unsplash.CollectionPhotoOptions{CollectionID: "c1", PhotoID: "abc"})
+	require.Nil(t, err)
+	fetch()
+	assert.Equal(t, 2, requests["GET /collections/c1"])
+	assert.Equal(t, 2, requests["GET /collections/c1/photos"])
+	assert.Equal(t, 2, requests["GET /photos/abc"])
+
+	_, err = c.DeleteCollection(ctx, "c1")
+	require.Nil(t, err)
+	fetch()
+	assert.Equal(t, 3, requests["GET /collections/c1"])
+	assert.Equal(t, 3, requests["GET /collections/c1/photos"])
+	assert.Equal(t, 2, requests["GET /photos/abc"])
+}
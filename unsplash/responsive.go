@@ -0,0 +1,218 @@
+package unsplash
+
+import (
+	"fmt"
+	"html"
+	"math"
+	"sort"
+	"strings"
+)
+
+// Breakpoint is the display width of a photo for viewports up to MaxViewport.
+type Breakpoint struct {
+	// MaxViewport Max viewport width in CSS pixels. 0 means any viewport and
+	// must be used by the last breakpoint only.
+	MaxViewport int
+	// Width Display width of the photo in CSS pixels.
+	Width int
+}
+
+type ResponsiveOptions struct {
+	// Breakpoints Display widths of the photo.
+	Breakpoints []Breakpoint
+	// DPRs Device pixel ratios to generate candidates for. (Optional; default: 1, 2)
+	DPRs []float64
+	// Quality Image quality 1..100. (Optional)
+	Quality int
+}
+
+func (o ResponsiveOptions) validate() error {
+	if len(o.Breakpoints) == 0 {
+		return ErrBadRequest
+	}
+
+	for i, bp := range o.Breakpoints {
+		if bp.Width <= 0 || bp.MaxViewport < 0 {
+			return ErrBadRequest
+		}
+
+		if bp.MaxViewport == 0 && i != len(o.Breakpoints)-1 {
+			return ErrBadRequest
+		}
+	}
+
+	for _, dpr := range o.DPRs {
+		if dpr <= 0 {
+			return ErrBadRequest
+		}
+	}
+
+	return nil
+}
+
+// SrcSet holds values of srcset, sizes, src, width and height attributes.
+type SrcSet struct {
+	SrcSet string
+	Sizes  string
+	// Src is a fallback URL for the widest breakpoint at DPR 1.
+	Src string
+	// Width and Height are dimensions of Src which keep photo's aspect ratio.
+	Width  int
+	Height int
+}
+
+// PictureSource is a <source> of a <picture> element.
+type PictureSource struct {
+	Type   string
+	SrcSet string
+	Sizes  string
+}
+
+// Picture holds AVIF and WebP sources and a JPEG fallback for a <picture>
+// element.
+type Picture struct {
+	Sources []PictureSource
+	Img     SrcSet
+}
+
+// SrcSet returns srcset and sizes for the breakpoints. Candidate widths are
+// never larger than the photo itself; imgix keeps the aspect ratio.
+func (p *Photo) SrcSet(opts ResponsiveOptions) (*SrcSet, error) {
+	return p.srcSet(opts, "")
+}
+
+// Picture returns AVIF, WebP and JPEG variants of SrcSet.
+func (p *Photo) Picture(opts ResponsiveOptions) (*Picture, error) {
+	img, err := p.srcSet(opts, FormatJPG)
+	if err != nil {
+		return nil, err
+	}
+
+	picture := Picture{Img: *img}
+	for _, fm := range []Format{FormatAVIF, FormatWebP} {
+		set, err := p.srcSet(opts, fm)
+		if err != nil {
+			return nil, err
+		}
+
+		picture.Sources = append(picture.Sources, PictureSource{
+			Type:   "image/" + string(fm),
+			SrcSet: set.SrcSet,
+			Sizes:  set.Sizes,
+		})
+	}
+
+	return &picture, nil
+}
+
+// HTML renders the <picture> element. alt is escaped.
+func (p *Picture) HTML(alt string) string {
+	var b strings.Builder
+
+	b.WriteString("<picture>")
+	for _, src := range p.Sources {
+		fmt.Fprintf(&b, `<source type="%s" srcset="%s" sizes="%s">`,
+			src.Type, html.EscapeString(src.SrcSet), html.EscapeString(src.Sizes))
+	}
+
+	fmt.Fprintf(&b, `<img src="%s" srcset="%s" sizes="%s"`,
+		html.EscapeString(p.Img.Src), html.EscapeString(p.Img.SrcSet), html.EscapeString(p.Img.Sizes))
+	if p.Img.Width > 0 && p.Img.Height > 0 {
+		fmt.Fprintf(&b, ` width="%d" height="%d"`, p.Img.Width, p.Img.Height)
+	}
+
+	fmt.Fprintf(&b, ` alt="%s"></picture>`, html.EscapeString(alt))
+
+	return b.String()
+}
+
+func (p *Photo) srcSet(opts ResponsiveOptions, fm Format) (*SrcSet, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	b, err := p.URLBuilder()
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Quality > 0 {
+		b = b.Quality(opts.Quality)
+	}
+
+	if fm != "" {
+		b = b.Format(fm)
+	}
+
+	dprs := opts.DPRs
+	if len(dprs) == 0 {
+		dprs = []float64{1, 2}
+	}
+
+	seen := make(map[int]bool)
+	var widths []int
+	for _, bp := range opts.Breakpoints {
+		for _, dpr := range dprs {
+			w := p.limitWidth(int(math.Round(float64(bp.Width) * dpr)))
+			if !seen[w] {
+				seen[w] = true
+				widths = append(widths, w)
+			}
+		}
+	}
+
+	sort.Ints(widths)
+
+	candidates := make([]string, len(widths))
+	for i, w := range widths {
+		candidates[i] = fmt.Sprintf("%s %dw", b.Width(w), w)
+	}
+
+	sizes := make([]string, 0, len(opts.Breakpoints))
+	for _, bp := range opts.Breakpoints {
+		if bp.MaxViewport == 0 {
+			sizes = append(sizes, fmt.Sprintf("%dpx", bp.Width))
+		} else {
+			sizes = append(sizes, fmt.Sprintf("(max-width: %dpx) %dpx", bp.MaxViewport, bp.Width))
+		}
+	}
+
+	if last := opts.Breakpoints[len(opts.Breakpoints)-1]; last.MaxViewport != 0 {
+		sizes = append(sizes, fmt.Sprintf("%dpx", last.Width))
+	}
+
+	widest := 0
+	for _, bp := range opts.Breakpoints {
+		if bp.Width > widest {
+			widest = bp.Width
+		}
+	}
+
+	widest = p.limitWidth(widest)
+
+	return &SrcSet{
+		SrcSet: strings.Join(candidates, ", "),
+		Sizes:  strings.Join(sizes, ", "),
+		Src:    b.Width(widest).String(),
+		Width:  widest,
+		Height: p.heightFor(widest),
+	}, nil
+}
+
+// limitWidth prevents upscaling when the photo width is known.
+func (p *Photo) limitWidth(w int) int {
+	if p.Width > 0 && w > p.Width {
+		return p.Width
+	}
+
+	return w
+}
+
+// heightFor returns the height which keeps the photo's aspect ratio.
+func (p *Photo) heightFor(w int) int {
+	if p.Width <= 0 || p.Height <= 0 {
+		return 0
+	}
+
+	return int(math.Round(float64(w) * float64(p.Height) / float64(p.Width)))
+}
@@ -0,0 +1,87 @@
+package unsplash_test
+
+import (
+	"errors"
+	"github.com/kazhuravlev/go-unsplash/unsplash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+func TestPhoto_SrcSet(t *testing.T) {
+	photo := unsplash.Photo{
+		Width:  3000,
+		Height: 2000,
+		Urls:   unsplash.Urls{Raw: "https://images.unsplash.com/photo-1?ixid=abc"},
+	}
+
+	set, err := photo.SrcSet(unsplash.ResponsiveOptions{
+		Breakpoints: []unsplash.Breakpoint{
+			{MaxViewport: 600, Width: 400},
+			{MaxViewport: 1200, Width: 800},
+			{Width: 1600},
+		},
+		Quality: 75,
+	})
+	require.Nil(t, err)
+
+	assert.Equal(t, strings.Join([]string{
+		"https://images.unsplash.com/photo-1?ixid=abc&q=75&w=400 400w",
+		"https://images.unsplash.com/photo-1?ixid=abc&q=75&w=800 800w",
+		"https://images.unsplash.com/photo-1?ixid=abc&q=75&w=1600 1600w",
+		// 3200 is larger than the photo
+		"https://images.unsplash.com/photo-1?ixid=abc&q=75&w=3000 3000w",
+	}, ", "), set.SrcSet)
+	assert.Equal(t, "(max-width: 600px) 400px, (max-width: 1200px) 800px, 1600px", set.Sizes)
+	assert.Equal(t, "https://images.unsplash.com/photo-1?ixid=abc&q=75&w=1600", set.Src)
+	assert.Equal(t, 1600, set.Width)
+	assert.Equal(t, 1067, set.Height)
+}
+
+func TestPhoto_Picture(t *testing.T) {
+	photo := unsplash.Photo{
+		Width:  1000,
+		Height: 500,
+		Urls:   unsplash.Urls{Raw: "https://images.unsplash.com/photo-1?ixid=abc"},
+	}
+
+	picture, err := photo.Picture(unsplash.ResponsiveOptions{
+		Breakpoints: []unsplash.Breakpoint{{MaxViewport: 640, Width: 320}},
+		DPRs:        []float64{1},
+	})
+	require.Nil(t, err)
+
+	require.Len(t, picture.Sources, 2)
+	assert.Equal(t, unsplash.PictureSource{
+		Type:   "image/avif",
+		SrcSet: "https://images.unsplash.com/photo-1?fm=avif&ixid=abc&w=320 320w",
+		Sizes:  "(max-width: 640px) 320px, 320px",
+	}, picture.Sources[0])
+	assert.Equal(t, "image/webp", picture.Sources[1].Type)
+	assert.Equal(t, "https://images.unsplash.com/photo-1?fm=jpg&ixid=abc&w=320", picture.Img.Src)
+	assert.Equal(t, 160, picture.Img.Height)
+
+	assert.Equal(t, `<picture>`+
+		`<source type="image/avif" srcset="https://images.unsplash.com/photo-1?fm=avif&amp;ixid=abc&amp;w=320 320w" sizes="(max-width: 640px) 320px, 320px">`+
+		`<source type="image/webp" srcset="https://images.unsplash.com/photo-1?fm=webp&amp;ixid=abc&amp;w=320 320w" sizes="(max-width: 640px) 320px, 320px">`+
+		`<img src="https://images.unsplash.com/photo-1?fm=jpg&amp;ixid=abc&amp;w=320" srcset="https://images.unsplash.com/photo-1?fm=jpg&amp;ixid=abc&amp;w=320 320w" sizes="(max-width: 640px) 320px, 320px" width="320" height="160" alt="a &#34;red&#34; car">`+
+		`</picture>`, picture.HTML(`a "red" car`))
+}
+
+func TestPhoto_SrcSet_Invalid(t *testing.T) {
+	photo := unsplash.Photo{Urls: unsplash.Urls{Raw: "https://images.unsplash.com/photo-1"}}
+
+	for _, opts := range []unsplash.ResponsiveOptions{
+		{},
+		{Breakpoints: []unsplash.Breakpoint{{Width: 0}}},
+		{Breakpoints: []unsplash.Breakpoint{{Width: 100}, {MaxViewport: 600, Width: 50}}},
+		{Breakpoints: []unsplash.Breakpoint{{Width: 100}}, DPRs: []float64{-1}},
+	} {
+		_, err := photo.SrcSet(opts)
+		assert.True(t, errors.Is(err, unsplash.ErrBadRequest))
+	}
+
+	_, err := (&unsplash.Photo{}).Picture(unsplash.ResponsiveOptions{Breakpoints: []unsplash.Breakpoint{{Width: 100}}})
+	assert.True(t, errors.Is(err, unsplash.ErrBadRequest))
+}
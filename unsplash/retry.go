@@ -0,0 +1,129 @@
+package unsplash
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures retries of transient failures: connection errors,
+// 429 and 5xx responses. Only idempotent requests (GET, HEAD, OPTIONS) are
+// retried unless RetryNonIdempotent is set.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts including the first one.
+	MaxAttempts int
+	// MinBackoff is the base delay of exponential backoff. (Default: 100ms)
+	MinBackoff time.Duration
+	// MaxBackoff caps the delay between attempts including Retry-After.
+	// (Default: 10s)
+	MaxBackoff time.Duration
+	// RetryNonIdempotent enables retries of POST, PUT and DELETE requests
+	// like LikePhoto or UpdatePhoto.
+	RetryNonIdempotent bool
+}
+
+// WithRetry enables retries of transient failures.
+func WithRetry(policy RetryPolicy) Option {
+	return func(c *Client) error {
+		if policy.MaxAttempts < 1 || policy.MinBackoff < 0 || policy.MaxBackoff < 0 {
+			return ErrBadRequest
+		}
+
+		if policy.MinBackoff == 0 {
+			policy.MinBackoff = 100 * time.Millisecond
+		}
+
+		if policy.MaxBackoff == 0 {
+			policy.MaxBackoff = 10 * time.Second
+		}
+
+		if policy.MaxBackoff < policy.MinBackoff {
+			return ErrBadRequest
+		}
+
+		c.retry = &policy
+		return nil
+	}
+}
+
+func (p *RetryPolicy) retryable(req *http.Request) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	default:
+		return p.RetryNonIdempotent
+	}
+}
+
+func (p *RetryPolicy) shouldRetry(ctx context.Context, resp *http.Response, err error) bool {
+	if err != nil {
+		// quota errors of the rate limiter are not transient
+		return ctx.Err() == nil && !errors.Is(err, ErrRateLimited)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// backoff returns the delay before attempt (counting from 1). Retry-After
+// header of resp takes precedence over exponential backoff with full jitter.
+func (p *RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if d, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			if d > p.MaxBackoff {
+				d = p.MaxBackoff
+			}
+			return d
+		}
+	}
+
+	d := p.MinBackoff << uint(attempt-1)
+	if d > p.MaxBackoff || d <= 0 {
+		d = p.MaxBackoff
+	}
+
+	return time.Duration(rand.Int63n(int64(d) + 1))
+}
+
+// retryAfter parses Retry-After header given in seconds or as HTTP date.
+func retryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+
+	return 0, false
+}
+
+// drain discards the rest of body so the connection can be reused.
+func drain(body io.ReadCloser) {
+	io.Copy(io.Discard, io.LimitReader(body, maxErrorBody))
+	body.Close()
+}
@@ -0,0 +1,106 @@
+package unsplash_test
+
+import (
+	"context"
+	"errors"
+	"github.com/kazhuravlev/go-unsplash/unsplash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+var testRetryPolicy = unsplash.RetryPolicy{
+	MaxAttempts: 3,
+	MinBackoff:  time.Millisecond,
+	MaxBackoff:  5 * time.Millisecond,
+}
+
+// newFlakyServer fails the first failures requests with status.
+func newFlakyServer(failures, status int, requests *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		w.Header().Set("X-Ratelimit-Limit", "50")
+		w.Header().Set("X-Ratelimit-Remaining", "49")
+		if *requests <= failures {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(status)
+			return
+		}
+
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+		}
+		w.Write([]byte(`{"id":"abc"}`))
+	}))
+}
+
+func TestWithRetry(t *testing.T) {
+	var requests int
+	srv := newFlakyServer(2, http.StatusServiceUnavailable, &requests)
+	defer srv.Close()
+
+	c, err := unsplash.New(unsplash.WithBaseURL(srv.URL), unsplash.WithRetry(testRetryPolicy))
+	require.Nil(t, err)
+
+	photo, _, err := c.GetPhoto(context.Background(), "abc")
+	require.Nil(t, err)
+	assert.Equal(t, "abc", photo.ID)
+	assert.Equal(t, 3, requests)
+}
+
+func TestWithRetry_Exhausted(t *testing.T) {
+	var requests int
+	srv := newFlakyServer(5, http.StatusTooManyRequests, &requests)
+	defer srv.Close()
+
+	c, err := unsplash.New(unsplash.WithBaseURL(srv.URL), unsplash.WithRetry(testRetryPolicy))
+	require.Nil(t, err)
+
+	_, _, err = c.GetPhoto(context.Background(), "abc")
+	assert.True(t, errors.Is(err, unsplash.ErrRateLimited))
+	assert.Equal(t, 3, requests)
+}
+
+func TestWithRetry_NonIdempotent(t *testing.T) {
+	var requests int
+	srv := newFlakyServer(1, http.StatusBadGateway, &requests)
+	defer srv.Close()
+
+	c, err := unsplash.New(unsplash.WithBaseURL(srv.URL), unsplash.WithRetry(testRetryPolicy))
+	require.Nil(t, err)
+
+	_, _, err = c.LikePhoto(context.Background(), "abc")
+	assert.True(t, errors.Is(err, unsplash.ErrServer))
+	assert.Equal(t, 1, requests)
+
+	policy := testRetryPolicy
+	policy.RetryNonIdempotent = true
+	c, err = unsplash.New(unsplash.WithBaseURL(srv.URL), unsplash.WithRetry(policy))
+	require.Nil(t, err)
+
+	requests = 0
+	_, _, err = c.LikePhoto(context.Background(), "abc")
+	require.Nil(t, err)
+	assert.Equal(t, 2, requests)
+}
+
+func TestWithRetry_NotTransient(t *testing.T) {
+	var requests int
+	srv := newFlakyServer(1, http.StatusNotFound, &requests)
+	defer srv.Close()
+
+	c, err := unsplash.New(unsplash.WithBaseURL(srv.URL), unsplash.WithRetry(testRetryPolicy))
+	require.Nil(t, err)
+
+	_, _, err = c.GetPhoto(context.Background(), "abc")
+	assert.True(t, errors.Is(err, unsplash.ErrNotFound))
+	assert.Equal(t, 1, requests)
+}
+
+func TestWithRetry_Invalid(t *testing.T) {
+	_, err := unsplash.New(unsplash.WithRetry(unsplash.RetryPolicy{}))
+	assert.Equal(t, unsplash.ErrBadRequest, err)
+}
@@ -0,0 +1,72 @@
+package unsplash
+
+// ColorFilter is a color of photos in search results.
+type ColorFilter string
+
+const (
+	ColorFilterBlackAndWhite ColorFilter = "black_and_white"
+	ColorFilterBlack         ColorFilter = "black"
+	ColorFilterWhite         ColorFilter = "white"
+	ColorFilterYellow        ColorFilter = "yellow"
+	ColorFilterOrange        ColorFilter = "orange"
+	ColorFilterRed           ColorFilter = "red"
+	ColorFilterPurple        ColorFilter = "purple"
+	ColorFilterMagenta       ColorFilter = "magenta"
+	ColorFilterGreen         ColorFilter = "green"
+	ColorFilterTeal          ColorFilter = "teal"
+	ColorFilterBlue          ColorFilter = "blue"
+)
+
+func (c ColorFilter) valid() bool {
+	switch c {
+	case ColorFilterBlackAndWhite, ColorFilterBlack, ColorFilterWhite, ColorFilterYellow, ColorFilterOrange,
+		ColorFilterRed, ColorFilterPurple, ColorFilterMagenta, ColorFilterGreen, ColorFilterTeal, ColorFilterBlue:
+		return true
+	}
+
+	return false
+}
+
+// ContentFilter limits search results by content safety.
+type ContentFilter string
+
+const (
+	ContentFilterLow  ContentFilter = "low"
+	ContentFilterHigh ContentFilter = "high"
+)
+
+// Lang is an ISO 639-1 language code of a search query. Constants cover
+// common languages; other languages supported by search are passed as
+// Lang("xx"), e.g. Lang("zh-TW"). Only codes listed in supportedLangs are
+// accepted; others are rejected with ErrBadRequest.
+type Lang string
+
+const (
+	LangEnglish Lang = "en"
+	LangFrench  Lang = "fr"
+	LangGerman  Lang = "de"
+	LangSpanish Lang = "es"
+	LangRussian Lang = "ru"
+)
+
+// supportedLangs are languages supported by search.
+var supportedLangs = map[Lang]bool{
+	"af": true, "am": true, "ar": true, "az": true, "be": true, "bg": true, "bn": true, "bs": true,
+	"ca": true, "ceb": true, "co": true, "cs": true, "cy": true, "da": true, "de": true, "el": true,
+	"en": true, "eo": true, "es": true, "et": true, "eu": true, "fa": true, "fi": true, "fr": true,
+	"fy": true, "ga": true, "gd": true, "gl": true, "gu": true, "ha": true, "haw": true, "hi": true,
+	"hmn": true, "hr": true, "ht": true, "hu": true, "hy": true, "id": true, "ig": true, "is": true,
+	"it": true, "iw": true, "ja": true, "jw": true, "ka": true, "kk": true, "km": true, "kn": true,
+	"ko": true, "ku": true, "ky": true, "la": true, "lb": true, "lo": true, "lt": true, "lv": true,
+	"mg": true, "mi": true, "mk": true, "ml": true, "mn": true, "mr": true, "ms": true, "mt": true,
+	"my": true, "ne": true, "nl": true, "no": true, "ny": true, "or": true, "pa": true, "pl": true,
+	"ps": true, "pt": true, "ro": true, "ru": true, "rw": true, "sd": true, "si": true, "sk": true,
+	"sl": true, "sm": true, "sn": true, "so": true, "sq": true, "sr": true, "st": true, "su": true,
+	"sv": true, "sw": true, "ta": true, "te": true, "tg": true, "th": true, "tk": true, "tl": true,
+	"tr": true, "tt": true, "ug": true, "uk": true, "ur": true, "uz": true, "vi": true, "xh": true,
+	"yi": true, "yo": true, "zh": true, "zh-TW": true, "zu": true,
+}
+
+func (l Lang) valid() bool {
+	return supportedLangs[l]
+}
@@ -0,0 +1,60 @@
+package unsplash_test
+
+import (
+	"context"
+	"errors"
+	"github.com/kazhuravlev/go-unsplash/unsplash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestSearchPhotos_Filters(t *testing.T) {
+	var query url.Values
+	c, closeFn := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Write([]byte(`{"total":1,"total_pages":1,"results":[{"id":"abc"}]}`))
+	})
+	defer closeFn()
+
+	res, _, err := c.SearchPhotos(context.Background(), unsplash.SearchPhotosOptions{
+		Query:         "car",
+		Collections:   []string{"1", "2"},
+		OrderBy:       unsplash.OrderByLatest,
+		Color:         unsplash.ColorFilterBlackAndWhite,
+		ContentFilter: unsplash.ContentFilterHigh,
+		Lang:          unsplash.LangFrench,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, "abc", res.Results[0].ID)
+
+	assert.Equal(t, url.Values{
+		"query":          {"car"},
+		"collections":    {"1,2"},
+		"order_by":       {"latest"},
+		"color":          {"black_and_white"},
+		"content_filter": {"high"},
+		"lang":           {"fr"},
+		"page":           {"1"},
+		"per_page":       {"10"},
+	}, query)
+}
+
+func TestSearchPhotos_Validate(t *testing.T) {
+	c, err := unsplash.New()
+	require.Nil(t, err)
+
+	for _, opts := range []unsplash.SearchPhotosOptions{
+		{},
+		{Collections: []string{"1"}},
+		{Query: "car", OrderBy: unsplash.OrderByPopular},
+		{Query: "car", Color: "pink"},
+		{Query: "car", ContentFilter: "none"},
+		{Query: "car", Lang: "english"},
+	} {
+		_, _, err := c.SearchPhotos(context.Background(), opts)
+		assert.True(t, errors.Is(err, unsplash.ErrBadRequest), opts)
+	}
+}
@@ -0,0 +1,80 @@
+package unsplash
+
+import (
+	"context"
+	"io"
+)
+
+// PhotosService is the set of photo methods of Client. Depend on it instead
+// of *Client to replace the client with a fake in tests.
+type PhotosService interface {
+	GetRandomPhotos(ctx context.Context, opts GetRandomPhotosOptions) ([]Photo, *RateLimit, error)
+	GetPhotos(ctx context.Context, opts GetPhotosOptions) ([]Photo, *RateLimit, error)
+	GetCuratedPhotos(ctx context.Context, opts GetPhotosOptions) ([]Photo, *RateLimit, error)
+	GetPhoto(ctx context.Context, id string) (*Photo, *RateLimit, error)
+	GetPhotoStatistics(ctx context.Context, opts GetPhotoStatisticsOptions) (*PhotoStatistics, *RateLimit, error)
+	GetPhotoDownload(ctx context.Context, id string) (*PhotoDownload, *RateLimit, error)
+	UpdatePhoto(ctx context.Context, opts UpdatePhotoOptions) (*Photo, *RateLimit, error)
+	LikePhoto(ctx context.Context, id string) (*Photo, *RateLimit, error)
+	UnlikePhoto(ctx context.Context, id string) (*Photo, *RateLimit, error)
+	TrackDownload(ctx context.Context, photo *Photo) (*PhotoDownload, *RateLimit, error)
+	DownloadPhoto(ctx context.Context, photo *Photo, w io.Writer) (*RateLimit, error)
+}
+
+// SearchService is the set of search methods of Client.
+type SearchService interface {
+	SearchPhotos(ctx context.Context, opts SearchPhotosOptions) (*SearchResult, *RateLimit, error)
+	SearchCollections(ctx context.Context, opts SearchCollectionsOptions) (*CollectionSearchResult, *RateLimit, error)
+	SearchUsers(ctx context.Context, opts SearchUsersOptions) (*UsersSearchResult, *RateLimit, error)
+}
+
+// UsersService is the set of user methods of Client, including the current
+// user ones.
+type UsersService interface {
+	GetUser(ctx context.Context, username string) (*User, *RateLimit, error)
+	GetUserPortfolio(ctx context.Context, username string) (*UserPortfolio, *RateLimit, error)
+	ListUserPhotos(ctx context.Context, opts ListUserPhotosOptions) ([]Photo, *RateLimit, error)
+	ListUserLikes(ctx context.Context, opts ListUserLikesOptions) ([]Photo, *RateLimit, error)
+	ListUserCollections(ctx context.Context, opts ListUserCollectionsOptions) ([]Collection, *RateLimit, error)
+	GetUserStatistics(ctx context.Context, opts GetUserStatisticsOptions) (*UserStatistics, *RateLimit, error)
+	GetCurrentUser(ctx context.Context) (*CurrentUser, *RateLimit, error)
+	UpdateCurrentUser(ctx context.Context, opts UpdateCurrentUserOptions) (*CurrentUser, *RateLimit, error)
+}
+
+// CollectionsService is the set of collection methods of Client.
+type CollectionsService interface {
+	ListCollections(ctx context.Context, opts ListCollectionsOptions) ([]Collection, *RateLimit, error)
+	GetCollection(ctx context.Context, id string) (*Collection, *RateLimit, error)
+	ListCollectionPhotos(ctx context.Context, opts ListCollectionPhotosOptions) ([]Photo, *RateLimit, error)
+	ListRelatedCollections(ctx context.Context, id string) ([]Collection, *RateLimit, error)
+	CreateCollection(ctx context.Context, opts CreateCollectionOptions) (*Collection, *RateLimit, error)
+	UpdateCollection(ctx context.Context, opts UpdateCollectionOptions) (*Collection, *RateLimit, error)
+	DeleteCollection(ctx context.Context, id string) (*RateLimit, error)
+	AddPhotoToCollection(ctx context.Context, opts CollectionPhotoOptions) (*CollectedPhoto, *RateLimit, error)
+	RemovePhotoFromCollection(ctx context.Context, opts CollectionPhotoOptions) (*CollectedPhoto, *RateLimit, error)
+}
+
+// TopicsService is the set of topic methods of Client.
+type TopicsService interface {
+	ListTopics(ctx context.Context, opts ListTopicsOptions) ([]Topic, *RateLimit, error)
+	GetTopic(ctx context.Context, idOrSlug string) (*Topic, *RateLimit, error)
+	ListTopicPhotos(ctx context.Context, opts ListTopicPhotosOptions) ([]Photo, *RateLimit, error)
+}
+
+// StatsService is the set of stats methods of Client.
+type StatsService interface {
+	GetTotalStats(ctx context.Context) (*TotalStats, *RateLimit, error)
+	GetMonthStats(ctx context.Context) (*MonthStats, *RateLimit, error)
+}
+
+// Service combines all API services. Client implements it.
+type Service interface {
+	PhotosService
+	SearchService
+	UsersService
+	CollectionsService
+	TopicsService
+	StatsService
+}
+
+var _ Service = (*Client)(nil)
@@ -0,0 +1,28 @@
+package unsplash
+
+import (
+	"context"
+	"net/http"
+)
+
+// GetTotalStats returns a list of counts for all of Unsplash.
+func (c *Client) GetTotalStats(ctx context.Context) (*TotalStats, *RateLimit, error) {
+	var stats TotalStats
+	rl, err := c.do(ctx, http.MethodGet, "/stats/total", nil, nil, http.StatusOK, &stats)
+	if err != nil {
+		return nil, rl, err
+	}
+
+	return &stats, rl, nil
+}
+
+// GetMonthStats returns the overall Unsplash stats for the past 30 days.
+func (c *Client) GetMonthStats(ctx context.Context) (*MonthStats, *RateLimit, error) {
+	var stats MonthStats
+	rl, err := c.do(ctx, http.MethodGet, "/stats/month", nil, nil, http.StatusOK, &stats)
+	if err != nil {
+		return nil, rl, err
+	}
+
+	return &stats, rl, nil
+}
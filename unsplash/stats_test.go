@@ -0,0 +1,37 @@
+package unsplash_test
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"testing"
+)
+
+func TestGetTotalStats(t *testing.T) {
+	c, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/stats/total", r.URL.Path)
+		w.Write([]byte(`{"photos":3000000,"pixels":49000000000000,"downloads_per_second":9}`))
+	})
+	defer done()
+
+	stats, rl, err := c.GetTotalStats(context.Background())
+	require.Nil(t, err)
+	assert.NotNil(t, rl)
+	assert.Equal(t, int64(3000000), stats.Photos)
+	assert.Equal(t, int64(49000000000000), stats.Pixels)
+	assert.Equal(t, int64(9), stats.DownloadsPerSecond)
+}
+
+func TestGetMonthStats(t *testing.T) {
+	c, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/stats/month", r.URL.Path)
+		w.Write([]byte(`{"downloads":100,"new_photos":20}`))
+	})
+	defer done()
+
+	stats, _, err := c.GetMonthStats(context.Background())
+	require.Nil(t, err)
+	assert.Equal(t, int64(100), stats.Downloads)
+	assert.Equal(t, int64(20), stats.NewPhotos)
+}
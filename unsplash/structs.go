@@ -1,5 +1,7 @@
 package unsplash
 
+import "encoding/json"
+
 type Exif struct {
 	Make         string `json:"make"`
 	Model        string `json:"model"`
@@ -23,13 +25,13 @@ type Location struct {
 }
 
 type CurrentUserCollection struct {
-	ID          int         `json:"id"`
-	Title       string      `json:"title"`
-	PublishedAt string      `json:"published_at"`
-	UpdatedAt   string      `json:"updated_at"`
-	Curated     bool        `json:"curated"`
-	CoverPhoto  interface{} `json:"cover_photo"`
-	User        interface{} `json:"user"`
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	PublishedAt Time   `json:"published_at"`
+	UpdatedAt   Time   `json:"updated_at"`
+	Curated     bool   `json:"curated"`
+	CoverPhoto  *Photo `json:"cover_photo"`
+	User        *User  `json:"user"`
 }
 
 type Urls struct {
@@ -57,9 +59,16 @@ type UserLinks struct {
 	Followers string `json:"followers"`
 }
 
+type UserSocial struct {
+	InstagramUsername string `json:"instagram_username"`
+	PortfolioURL      string `json:"portfolio_url"`
+	TwitterUsername   string `json:"twitter_username"`
+	PaypalEmail       string `json:"paypal_email"`
+}
+
 type User struct {
 	ID                string    `json:"id"`
-	UpdatedAt         string    `json:"updated_at"`
+	UpdatedAt         Time      `json:"updated_at"`
 	Username          string    `json:"username"`
 	Name              string    `json:"name"`
 	PortfolioURL      string    `json:"portfolio_url"`
@@ -76,34 +85,82 @@ type User struct {
 		Medium string `json:"medium"`
 		Large  string `json:"large"`
 	} `json:"profile_image"`
-	FirstName       string `json:"first_name"`
-	LastName        string `json:"last_name"`
-	TwitterUsername string `json:"twitter_username"`
+	FirstName           string     `json:"first_name"`
+	LastName            string     `json:"last_name"`
+	TwitterUsername     string     `json:"twitter_username"`
+	FollowedByUser      bool       `json:"followed_by_user"`
+	Downloads           int        `json:"downloads"`
+	ForHire             bool       `json:"for_hire"`
+	TotalPromotedPhotos int        `json:"total_promoted_photos"`
+	Social              UserSocial `json:"social"`
+	// Extra holds fields of the API response which are not modelled above.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+type CurrentUser struct {
+	User
+	Email            string `json:"email"`
+	UploadsRemaining int    `json:"uploads_remaining"`
+	NumericID        int    `json:"numeric_id"`
+}
+
+type UserPortfolio struct {
+	URL string `json:"url"`
+}
+
+type UserStatistics struct {
+	Username  string `json:"username"`
+	Downloads Stat   `json:"downloads"`
+	Views     Stat   `json:"views"`
 }
 
 type Photo struct {
-	ID                     string                  `json:"id"`
-	CreatedAt              string                  `json:"created_at"`
-	UpdatedAt              string                  `json:"updated_at"`
-	Width                  int                     `json:"width"`
-	Height                 int                     `json:"height"`
-	Color                  string                  `json:"color"`
-	Description            string                  `json:"description"`
-	Sponsored              bool                    `json:"sponsored"`
-	SponsoredBy            interface{}             `json:"sponsored_by"`
-	SponsoredImpressionsID interface{}             `json:"sponsored_impressions_id"`
-	Downloads              int                     `json:"downloads"`
-	Likes                  int                     `json:"likes"`
-	LikedByUser            bool                    `json:"liked_by_user"`
-	Exif                   Exif                    `json:"exif"`
-	Location               Location                `json:"location"`
-	CurrentUserCollections []CurrentUserCollection `json:"current_user_collections"`
-	Urls                   Urls                    `json:"urls"`
-	Links                  PhotoLinks              `json:"links"`
-	User                   User                    `json:"user"`
-	Categories             []string                `json:"categories"`
-	Views                  int                     `json:"views"`
-	Slug                   string                  `json:"slug"`
+	ID                     string                     `json:"id"`
+	CreatedAt              Time                       `json:"created_at"`
+	UpdatedAt              Time                       `json:"updated_at"`
+	Width                  int                        `json:"width"`
+	Height                 int                        `json:"height"`
+	Color                  Color                      `json:"color"`
+	BlurHash               string                     `json:"blur_hash"`
+	Description            string                     `json:"description"`
+	AltDescription         string                     `json:"alt_description"`
+	PromotedAt             Time                       `json:"promoted_at"`
+	Sponsored              bool                       `json:"sponsored"`
+	SponsoredBy            *User                      `json:"sponsored_by"`
+	SponsoredImpressionsID string                     `json:"sponsored_impressions_id"`
+	Sponsorship            *Sponsorship               `json:"sponsorship"`
+	TopicSubmissions       map[string]TopicSubmission `json:"topic_submissions"`
+	Premium                bool                       `json:"premium"`
+	Plus                   bool                       `json:"plus"`
+	Downloads              int                        `json:"downloads"`
+	Likes                  int                        `json:"likes"`
+	LikedByUser            bool                       `json:"liked_by_user"`
+	Exif                   Exif                       `json:"exif"`
+	Location               Location                   `json:"location"`
+	CurrentUserCollections []CurrentUserCollection    `json:"current_user_collections"`
+	Urls                   Urls                       `json:"urls"`
+	Links                  PhotoLinks                 `json:"links"`
+	User                   User                       `json:"user"`
+	Categories             []string                   `json:"categories"`
+	Views                  int                        `json:"views"`
+	Slug                   string                     `json:"slug"`
+	Statistics             *PhotoStatistics           `json:"statistics"`
+	// Extra holds fields of the API response which are not modelled above.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+type Sponsorship struct {
+	ImpressionURLs []string `json:"impression_urls"`
+	Tagline        string   `json:"tagline"`
+	TaglineURL     string   `json:"tagline_url"`
+	Sponsor        User     `json:"sponsor"`
+}
+
+// TopicSubmission is the status of a photo submitted to a topic, keyed by
+// topic slug in Photo.TopicSubmissions.
+type TopicSubmission struct {
+	Status     string `json:"status"`
+	ApprovedOn Time   `json:"approved_on"`
 }
 
 type Stat struct {
@@ -113,8 +170,8 @@ type Stat struct {
 		Resolution string `json:"resolution"`
 		Quantity   int    `json:"quantity"`
 		Values     []struct {
-			Date  string `json:"date"`
-			Value int    `json:"value"`
+			Date  Time `json:"date"`
+			Value int  `json:"value"`
 		} `json:"values"`
 	} `json:"historical"`
 }
@@ -126,6 +183,32 @@ type PhotoStatistics struct {
 	Likes     Stat   `json:"likes"`
 }
 
+type TotalStats struct {
+	Photos             int64 `json:"photos"`
+	Downloads          int64 `json:"downloads"`
+	Views              int64 `json:"views"`
+	Likes              int64 `json:"likes"`
+	Photographers      int64 `json:"photographers"`
+	Pixels             int64 `json:"pixels"`
+	DownloadsPerSecond int64 `json:"downloads_per_second"`
+	ViewsPerSecond     int64 `json:"views_per_second"`
+	Developers         int64 `json:"developers"`
+	Applications       int64 `json:"applications"`
+	Requests           int64 `json:"requests"`
+}
+
+type MonthStats struct {
+	Downloads        int64 `json:"downloads"`
+	Views            int64 `json:"views"`
+	Likes            int64 `json:"likes"`
+	NewPhotos        int64 `json:"new_photos"`
+	NewPhotographers int64 `json:"new_photographers"`
+	NewPixels        int64 `json:"new_pixels"`
+	NewDevelopers    int64 `json:"new_developers"`
+	NewApplications  int64 `json:"new_applications"`
+	NewRequests      int64 `json:"new_requests"`
+}
+
 type PhotoDownload struct {
 	URL string `json:"url"`
 }
@@ -151,6 +234,8 @@ type CollectionLinks struct {
 	HTML    string `json:"html"`
 	Photos  string `json:"photos"`
 	Related string `json:"related"`
+	// DownloadLocation is not returned for every collection.
+	DownloadLocation string `json:"download_location"`
 }
 
 type PreviewPhoto struct {
@@ -159,21 +244,59 @@ type PreviewPhoto struct {
 }
 
 type Collection struct {
-	ID            int             `json:"id"`
-	Title         string          `json:"title"`
-	Description   string          `json:"description"`
-	PublishedAt   string          `json:"published_at"`
-	UpdatedAt     string          `json:"updated_at"`
-	Curated       bool            `json:"curated"`
-	Featured      bool            `json:"featured"`
-	TotalPhotos   int             `json:"total_photos"`
-	Private       bool            `json:"private"`
-	ShareKey      string          `json:"share_key"`
-	Tags          []Tag           `json:"tags"`
-	CoverPhoto    Photo           `json:"cover_photo"`
-	PreviewPhotos []PreviewPhoto  `json:"preview_photos"`
-	User          User            `json:"user"`
-	Links         CollectionLinks `json:"links"`
+	ID              string          `json:"id"`
+	Title           string          `json:"title"`
+	Description     string          `json:"description"`
+	PublishedAt     Time            `json:"published_at"`
+	UpdatedAt       Time            `json:"updated_at"`
+	LastCollectedAt Time            `json:"last_collected_at"`
+	Curated         bool            `json:"curated"`
+	Featured        bool            `json:"featured"`
+	TotalPhotos     int             `json:"total_photos"`
+	Private         bool            `json:"private"`
+	ShareKey        string          `json:"share_key"`
+	Tags            []Tag           `json:"tags"`
+	CoverPhoto      Photo           `json:"cover_photo"`
+	PreviewPhotos   []PreviewPhoto  `json:"preview_photos"`
+	User            User            `json:"user"`
+	Links           CollectionLinks `json:"links"`
+	Sponsorship     *Sponsorship    `json:"sponsorship"`
+	// Extra holds fields of the API response which are not modelled above.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+type CollectedPhoto struct {
+	Photo      Photo      `json:"photo"`
+	Collection Collection `json:"collection"`
+	User       User       `json:"user"`
+	CreatedAt  Time       `json:"created_at"`
+}
+
+type TopicLinks struct {
+	Self   string `json:"self"`
+	HTML   string `json:"html"`
+	Photos string `json:"photos"`
+}
+
+type Topic struct {
+	ID                   string         `json:"id"`
+	Slug                 string         `json:"slug"`
+	Title                string         `json:"title"`
+	Description          string         `json:"description"`
+	PublishedAt          Time           `json:"published_at"`
+	UpdatedAt            Time           `json:"updated_at"`
+	StartsAt             Time           `json:"starts_at"`
+	EndsAt               Time           `json:"ends_at"`
+	OnlySubmissionsAfter *Time          `json:"only_submissions_after"`
+	Featured             bool           `json:"featured"`
+	TotalPhotos          int            `json:"total_photos"`
+	Status               string         `json:"status"`
+	Links                TopicLinks     `json:"links"`
+	Owners               []User         `json:"owners"`
+	CoverPhoto           Photo          `json:"cover_photo"`
+	PreviewPhotos        []PreviewPhoto `json:"preview_photos"`
+	// Extra holds fields of the API response which are not modelled above.
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
 type CollectionSearchResult struct {
@@ -0,0 +1,111 @@
+package unsplash_test
+
+import (
+	"encoding/json"
+	"github.com/kazhuravlev/go-unsplash/unsplash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestPhoto_UnmarshalJSON(t *testing.T) {
+	var photo unsplash.Photo
+	err := json.Unmarshal([]byte(`{
+		"id": "abc",
+		"alt_description": "a red car",
+		"promoted_at": "2021-03-01T10:00:00Z",
+		"sponsored_by": {"id": "s1", "username": "brand"},
+		"sponsored_impressions_id": "imp",
+		"sponsorship": {"impression_urls": ["https://ad.example/1"], "tagline": "Drive", "tagline_url": "https://brand.example", "sponsor": {"username": "brand"}},
+		"topic_submissions": {"wallpapers": {"status": "approved", "approved_on": "2021-03-02T10:00:00Z"}},
+		"premium": true,
+		"plus": true,
+		"user": {"username": "jdoe", "social": {"instagram_username": "jd", "paypal_email": null}, "badge": {"title": "Pro"}},
+		"current_user_collections": [{"id": "wpdCVGoONYg", "cover_photo": {"id": "cp"}, "user": {"username": "me"}}],
+		"asset_type": "photo",
+		"breadcrumbs": []
+	}`), &photo)
+	require.Nil(t, err)
+
+	assert.Equal(t, "a red car", photo.AltDescription)
+	assert.True(t, photo.PromotedAt.Equal(time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)))
+	require.NotNil(t, photo.SponsoredBy)
+	assert.Equal(t, "brand", photo.SponsoredBy.Username)
+	assert.Equal(t, "imp", photo.SponsoredImpressionsID)
+	require.NotNil(t, photo.Sponsorship)
+	assert.Equal(t, []string{"https://ad.example/1"}, photo.Sponsorship.ImpressionURLs)
+	assert.Equal(t, "brand", photo.Sponsorship.Sponsor.Username)
+	assert.Equal(t, "approved", photo.TopicSubmissions["wallpapers"].Status)
+	assert.True(t, photo.Premium)
+	assert.True(t, photo.Plus)
+	assert.Equal(t, "jd", photo.User.Social.InstagramUsername)
+	assert.Equal(t, "cp", photo.CurrentUserCollections[0].CoverPhoto.ID)
+	assert.Equal(t, "me", photo.CurrentUserCollections[0].User.Username)
+
+	assert.Equal(t, map[string]json.RawMessage{
+		"asset_type":  json.RawMessage(`"photo"`),
+		"breadcrumbs": json.RawMessage(`[]`),
+	}, photo.Extra)
+	assert.Equal(t, map[string]json.RawMessage{"badge": json.RawMessage(`{"title": "Pro"}`)}, photo.User.Extra)
+	assert.Nil(t, photo.Sponsorship.Sponsor.Extra)
+}
+
+func TestPhoto_UnmarshalJSON_KnownFields(t *testing.T) {
+	var photo unsplash.Photo
+	err := json.Unmarshal([]byte(`{"\u0069d": "abc", "description": "{\"x\": 1, \"y\"", "urls": {"unknown": "u"}, "links": {"unknown": [1, {"a": "}"}]}}`), &photo)
+	require.Nil(t, err)
+
+	assert.Equal(t, "abc", photo.ID)
+	assert.Equal(t, `{"x": 1, "y"`, photo.Description)
+	assert.Nil(t, photo.Extra)
+}
+
+func TestEmbeddedUnmarshalJSON(t *testing.T) {
+	var res unsplash.SearchResult
+	err := json.Unmarshal([]byte(`{"total": 1, "results": [{"id": "abc", "tags": [{"title": "car"}], "photo_tags": [{"title": "red"}]}]}`), &res)
+	require.Nil(t, err)
+
+	require.Len(t, res.Results, 1)
+	assert.Equal(t, "abc", res.Results[0].ID)
+	assert.Equal(t, []unsplash.Tag{{Title: "car"}}, res.Results[0].Tags)
+	assert.Equal(t, []unsplash.Tag{{Title: "red"}}, res.Results[0].PhotoTags)
+	assert.Nil(t, res.Results[0].Extra)
+
+	var user unsplash.CurrentUser
+	err = json.Unmarshal([]byte(`{"username": "jdoe", "email": "jd@example.com", "uploads_remaining": 5, "numeric_id": 7, "unknown": 1}`), &user)
+	require.Nil(t, err)
+
+	assert.Equal(t, "jdoe", user.Username)
+	assert.Equal(t, "jd@example.com", user.Email)
+	assert.Equal(t, 5, user.UploadsRemaining)
+	assert.Equal(t, 7, user.NumericID)
+	assert.Equal(t, map[string]json.RawMessage{"unknown": json.RawMessage(`1`)}, user.Extra)
+}
+
+func TestCollection_UnmarshalJSON(t *testing.T) {
+	var collection unsplash.Collection
+	err := json.Unmarshal([]byte(`{"id": "wpdCVGoONYg", "links": {"download_location": "https://api.unsplash.com/collections/wpdCVGoONYg/download"}, "last_collected_at": "2021-03-01", "sponsorship": null, "media_types": ["photos"]}`), &collection)
+	require.Nil(t, err)
+
+	assert.Equal(t, "wpdCVGoONYg", collection.ID)
+	assert.Equal(t, "https://api.unsplash.com/collections/wpdCVGoONYg/download", collection.Links.DownloadLocation)
+	assert.True(t, collection.LastCollectedAt.Equal(time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Nil(t, collection.Sponsorship)
+	assert.Equal(t, map[string]json.RawMessage{"media_types": json.RawMessage(`["photos"]`)}, collection.Extra)
+}
+
+func TestTopic_UnmarshalJSON(t *testing.T) {
+	var topic unsplash.Topic
+	err := json.Unmarshal([]byte(`{"id": "bo8jQKTaE0Y", "starts_at": "2020-04-15T00:00:00Z", "only_submissions_after": "2021-01-01T10:00:00Z"}`), &topic)
+	require.Nil(t, err)
+
+	assert.True(t, topic.StartsAt.Equal(time.Date(2020, 4, 15, 0, 0, 0, 0, time.UTC)))
+	require.NotNil(t, topic.OnlySubmissionsAfter)
+	assert.True(t, topic.OnlySubmissionsAfter.Equal(time.Date(2021, 1, 1, 10, 0, 0, 0, time.UTC)))
+
+	topic = unsplash.Topic{}
+	err = json.Unmarshal([]byte(`{"id": "bo8jQKTaE0Y", "only_submissions_after": null}`), &topic)
+	require.Nil(t, err)
+	assert.Nil(t, topic.OnlySubmissionsAfter)
+}
@@ -0,0 +1,73 @@
+package unsplash
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// timeLayouts are tried in order when parsing API timestamps.
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+}
+
+// Time is an API timestamp. It embeds the parsed time.Time and keeps the raw
+// string as returned by the API. Parsing is tolerant: RFC3339 and date-only
+// values are accepted, and unknown formats leave Time zero with Raw set.
+// null and empty values decode to the zero Time.
+type Time struct {
+	time.Time
+	Raw string
+}
+
+// ParseTime parses s the same way as JSON decoding does.
+func ParseTime(s string) Time {
+	t := Time{Raw: s}
+	for _, layout := range timeLayouts {
+		if parsed, err := time.Parse(layout, s); err == nil {
+			t.Time = parsed
+			break
+		}
+	}
+
+	return t
+}
+
+func (t *Time) UnmarshalJSON(data []byte) error {
+	var s *string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	if s == nil {
+		*t = Time{}
+		return nil
+	}
+
+	*t = ParseTime(*s)
+
+	return nil
+}
+
+// MarshalJSON returns the raw string when it is set, so decoded values are
+// encoded back unchanged.
+func (t Time) MarshalJSON() ([]byte, error) {
+	switch {
+	case t.Raw != "":
+		return json.Marshal(t.Raw)
+	case t.IsZero():
+		return []byte("null"), nil
+	default:
+		return json.Marshal(t.Format(time.RFC3339))
+	}
+}
+
+// String returns the raw string when it is set.
+func (t Time) String() string {
+	if t.Raw != "" {
+		return t.Raw
+	}
+
+	return t.Time.String()
+}
@@ -0,0 +1,50 @@
+package unsplash_test
+
+import (
+	"encoding/json"
+	"github.com/kazhuravlev/go-unsplash/unsplash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestTime_UnmarshalJSON(t *testing.T) {
+	var photo unsplash.Photo
+	err := json.Unmarshal([]byte(`{
+		"created_at": "2018-12-10T09:21:53-05:00",
+		"updated_at": "2018-12-10",
+		"statistics": {"views": {"historical": {"values": [{"date": "2018-12-09", "value": 3}]}}},
+		"user": {"updated_at": null}
+	}`), &photo)
+	require.Nil(t, err)
+
+	assert.True(t, photo.CreatedAt.Equal(time.Date(2018, 12, 10, 14, 21, 53, 0, time.UTC)))
+	assert.Equal(t, "2018-12-10T09:21:53-05:00", photo.CreatedAt.Raw)
+	assert.True(t, photo.UpdatedAt.Equal(time.Date(2018, 12, 10, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, photo.Statistics.Views.Historical.Values[0].Date.Equal(time.Date(2018, 12, 9, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, photo.User.UpdatedAt.IsZero())
+	assert.True(t, photo.CreatedAt.After(photo.UpdatedAt.Time))
+
+	var tm unsplash.Time
+	require.Nil(t, json.Unmarshal([]byte(`"yesterday"`), &tm))
+	assert.True(t, tm.IsZero())
+	assert.Equal(t, "yesterday", tm.Raw)
+
+	assert.NotNil(t, json.Unmarshal([]byte(`42`), &tm))
+}
+
+func TestTime_MarshalJSON(t *testing.T) {
+	for _, raw := range []string{`"2018-12-10T09:21:53-05:00"`, `"2018-12-10"`, `"yesterday"`, `null`} {
+		var tm unsplash.Time
+		require.Nil(t, json.Unmarshal([]byte(raw), &tm))
+
+		data, err := json.Marshal(tm)
+		require.Nil(t, err)
+		assert.Equal(t, raw, string(data))
+	}
+
+	data, err := json.Marshal(unsplash.Time{Time: time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)})
+	require.Nil(t, err)
+	assert.Equal(t, `"2020-01-02T03:04:05Z"`, string(data))
+}
@@ -0,0 +1,181 @@
+package unsplash
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type ListTopicsOptions struct {
+	// IDs Limit to only matching topic ids or slugs. (Optional)
+	IDs []string
+	// Page Page number to retrieve. (Optional; default: 1)
+	Page int
+	// PerPage Number of items per page. (Optional; default: 10)
+	PerPage int
+	// OrderBy How to sort the topics. Valid values are featured, latest, oldest, position. (Optional; default: position)
+	OrderBy OrderBy
+}
+
+func (o ListTopicsOptions) validate() error {
+	if o.Page < 0 {
+		return ErrBadRequest
+	}
+
+	if o.PerPage < 0 {
+		return ErrBadRequest
+	}
+
+	if o.PerPage > maxListItems {
+		return ErrBadRequest
+	}
+
+	switch o.OrderBy {
+	case "", OrderByFeatured, OrderByLatest, OrderByOldest, OrderByPosition:
+	default:
+		return ErrBadRequest
+	}
+
+	return nil
+}
+
+func (o ListTopicsOptions) query() url.Values {
+	query := url.Values{}
+	if o.Page == 0 {
+		o.Page = 1
+	}
+
+	if o.PerPage == 0 {
+		o.PerPage = 10
+	}
+
+	if o.OrderBy == "" {
+		o.OrderBy = OrderByPosition
+	}
+
+	if len(o.IDs) != 0 {
+		query.Set("ids", strings.Join(o.IDs, ","))
+	}
+
+	query.Set("page", strconv.Itoa(o.Page))
+	query.Set("per_page", strconv.Itoa(o.PerPage))
+	query.Set("order_by", string(o.OrderBy))
+
+	return query
+}
+
+func (c *Client) ListTopics(ctx context.Context, opts ListTopicsOptions) ([]Topic, *RateLimit, error) {
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	var topics []Topic
+	rl, err := c.do(ctx, http.MethodGet, "/topics", opts.query(), nil, http.StatusOK, &topics)
+	if err != nil {
+		return nil, rl, err
+	}
+
+	return topics, rl, nil
+}
+
+// GetTopic returns topic by its id or slug.
+func (c *Client) GetTopic(ctx context.Context, idOrSlug string) (*Topic, *RateLimit, error) {
+	if idOrSlug == "" {
+		return nil, nil, ErrBadRequest
+	}
+
+	var topic Topic
+	rl, err := c.do(ctx, http.MethodGet, fmt.Sprintf("/topics/%s", idOrSlug), nil, nil, http.StatusOK, &topic)
+	if err != nil {
+		return nil, rl, err
+	}
+
+	return &topic, rl, nil
+}
+
+type ListTopicPhotosOptions struct {
+	// IDOrSlug The topic’s id or slug.
+	IDOrSlug string
+	// Page Page number to retrieve. (Optional; default: 1)
+	Page int
+	// PerPage Number of items per page. (Optional; default: 10)
+	PerPage int
+	// Orientation Filter by photo orientation. Valid values are landscape, portrait, and squarish.
+	Orientation Orientation
+	// OrderBy How to sort the photos. Valid values are latest, oldest, popular. (Optional; default: latest)
+	OrderBy OrderBy
+}
+
+func (o ListTopicPhotosOptions) validate() error {
+	if o.IDOrSlug == "" {
+		return ErrBadRequest
+	}
+
+	if o.Page < 0 {
+		return ErrBadRequest
+	}
+
+	if o.PerPage < 0 {
+		return ErrBadRequest
+	}
+
+	if o.PerPage > maxListItems {
+		return ErrBadRequest
+	}
+
+	switch o.Orientation {
+	case "", OrientationLandscape, OrientationPortrait, OrientationSquarish:
+	default:
+		return ErrBadRequest
+	}
+
+	switch o.OrderBy {
+	case "", OrderByLatest, OrderByOldest, OrderByPopular:
+	default:
+		return ErrBadRequest
+	}
+
+	return nil
+}
+
+func (o ListTopicPhotosOptions) query() url.Values {
+	query := url.Values{}
+	if o.Page == 0 {
+		o.Page = 1
+	}
+
+	if o.PerPage == 0 {
+		o.PerPage = 10
+	}
+
+	if o.OrderBy == "" {
+		o.OrderBy = OrderByLatest
+	}
+
+	if o.Orientation != "" {
+		query.Set("orientation", string(o.Orientation))
+	}
+
+	query.Set("page", strconv.Itoa(o.Page))
+	query.Set("per_page", strconv.Itoa(o.PerPage))
+	query.Set("order_by", string(o.OrderBy))
+
+	return query
+}
+
+func (c *Client) ListTopicPhotos(ctx context.Context, opts ListTopicPhotosOptions) ([]Photo, *RateLimit, error) {
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	var photos []Photo
+	rl, err := c.do(ctx, http.MethodGet, fmt.Sprintf("/topics/%s/photos", opts.IDOrSlug), opts.query(), nil, http.StatusOK, &photos)
+	if err != nil {
+		return nil, rl, err
+	}
+
+	return photos, rl, nil
+}
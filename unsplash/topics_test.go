@@ -0,0 +1,59 @@
+package unsplash_test
+
+import (
+	"context"
+	"github.com/kazhuravlev/go-unsplash/unsplash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"testing"
+)
+
+func TestListTopics(t *testing.T) {
+	c, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/topics", r.URL.Path)
+		assert.Equal(t, "wallpapers,nature", r.URL.Query().Get("ids"))
+		assert.Equal(t, "position", r.URL.Query().Get("order_by"))
+		w.Write([]byte(`[{"id":"bo8jQKTaE0Y","slug":"wallpapers"},{"id":"6sMVjTLSkeQ","slug":"nature"}]`))
+	})
+	defer done()
+
+	topics, _, err := c.ListTopics(context.Background(), unsplash.ListTopicsOptions{IDs: []string{"wallpapers", "nature"}})
+	require.Nil(t, err)
+	require.Len(t, topics, 2)
+	assert.Equal(t, "nature", topics[1].Slug)
+
+	_, _, err = c.ListTopics(context.Background(), unsplash.ListTopicsOptions{OrderBy: unsplash.OrderByPopular})
+	assert.Equal(t, unsplash.ErrBadRequest, err)
+}
+
+func TestGetTopic(t *testing.T) {
+	c, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/topics/wallpapers", r.URL.Path)
+		w.Write([]byte(`{"id":"bo8jQKTaE0Y","slug":"wallpapers","owners":[{"username":"unsplash"}]}`))
+	})
+	defer done()
+
+	topic, _, err := c.GetTopic(context.Background(), "wallpapers")
+	require.Nil(t, err)
+	assert.Equal(t, "bo8jQKTaE0Y", topic.ID)
+	require.Len(t, topic.Owners, 1)
+}
+
+func TestListTopicPhotos(t *testing.T) {
+	c, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/topics/wallpapers/photos", r.URL.Path)
+		assert.Equal(t, "landscape", r.URL.Query().Get("orientation"))
+		assert.Equal(t, "popular", r.URL.Query().Get("order_by"))
+		w.Write([]byte(`[{"id":"a"}]`))
+	})
+	defer done()
+
+	photos, _, err := c.ListTopicPhotos(context.Background(), unsplash.ListTopicPhotosOptions{
+		IDOrSlug:    "wallpapers",
+		Orientation: unsplash.OrientationLandscape,
+		OrderBy:     unsplash.OrderByPopular,
+	})
+	require.Nil(t, err)
+	assert.Len(t, photos, 1)
+}
@@ -1,23 +1,101 @@
 package unsplash
 
-import "net/http"
+import (
+	"github.com/sirupsen/logrus"
+	"net/http"
+	"time"
+)
 
 const apiVersion = "v1"
 
 type Transport struct {
-	base http.RoundTripper
+	base    http.RoundTripper
+	limiter *rateLimiter
+	retry   *RetryPolicy
+	cache   *httpCache
+	log     *logrus.Logger
 }
 
 func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
 	req.Header.Set("Accept-Version", apiVersion)
 
-	return t.base.RoundTrip(req)
+	if t.cache != nil {
+		return t.cache.roundTrip(req, t.retryRoundTrip)
+	}
+
+	return t.retryRoundTrip(req)
+}
+
+func (t *Transport) retryRoundTrip(req *http.Request) (*http.Response, error) {
+	if t.retry == nil || !t.retry.retryable(req) {
+		return t.roundTrip(req)
+	}
+
+	ctx := req.Context()
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		resp, err := t.roundTrip(req)
+		if attempt >= t.retry.MaxAttempts || !t.retry.shouldRetry(ctx, resp, err) {
+			return resp, err
+		}
+
+		delay := t.retry.backoff(attempt, resp)
+		if resp != nil {
+			drain(resp.Body)
+		}
+
+		t.log.WithFields(logrus.Fields{
+			"method":  req.Method,
+			"url":     req.URL.String(),
+			"attempt": attempt,
+			"delay":   delay,
+		}).Debug("unsplash: retrying request")
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (t *Transport) roundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	t.limiter.observe(resp)
+
+	return resp, nil
 }
 
-func newTransport(c *http.Client) *http.Client {
+func newTransport(c *http.Client, limiter *rateLimiter, retry *RetryPolicy, cache *httpCache, log *logrus.Logger) *http.Client {
+	base := c.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
 	return &http.Client{
 		Transport: &Transport{
-			base: c.Transport,
+			base:    base,
+			limiter: limiter,
+			retry:   retry,
+			cache:   cache,
+			log:     log,
 		},
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"net/http"
 	"strconv"
+	"strings"
 )
 
 var (
@@ -12,33 +13,26 @@ var (
 	ErrBadRequest    = errors.New("bad request")
 	ErrUnauthorized  = errors.New("unauthorized")
 	ErrForbidden     = errors.New("forbidden")
+	ErrNotFound      = errors.New("not found")
+	ErrRateLimited   = errors.New("rate limited")
+	ErrServer        = errors.New("server error")
+	ErrNoAppName     = errors.New("app name is not set")
 )
 
 const (
-	apiURL = "https://api.unsplash.com"
+	apiURL  = "https://api.unsplash.com"
+	siteURL = "https://unsplash.com/"
 
 	maxListItems = 30
 
 	paginatorHeaderPerPage = "X-Per-Page"
 	paginatorHeaderTotal   = "X-Total"
+	paginatorHeaderLink    = "Link"
 
 	rateLimitHeaderTotal     = "X-Ratelimit-Limit"
 	rateLimitHeaderRemaining = "X-Ratelimit-Remaining"
 )
 
-func handleError(resp *http.Response) error {
-	switch resp.StatusCode {
-	case http.StatusBadRequest:
-		return ErrBadRequest
-	case http.StatusUnauthorized:
-		return ErrUnauthorized
-	case http.StatusForbidden:
-		return ErrForbidden
-	default:
-		return ErrUnexpected
-	}
-}
-
 type RateLimit struct {
 	Limit     int
 	Remaining int
@@ -70,3 +64,38 @@ func getLimits(resp *http.Response) (*RateLimit, error) {
 		Remaining: int(remainingInt),
 	}, nil
 }
+
+// pageInfo describes pagination headers of a list response.
+type pageInfo struct {
+	// total is -1 when X-Total header is absent.
+	total   int
+	perPage int
+	// hasLink is true when Link header is present. Then hasNext reports
+	// whether it contains rel="next".
+	hasLink bool
+	hasNext bool
+}
+
+func getPageInfo(resp *http.Response) *pageInfo {
+	info := pageInfo{total: -1}
+
+	if total, err := strconv.Atoi(resp.Header.Get(paginatorHeaderTotal)); err == nil {
+		info.total = total
+	}
+
+	if perPage, err := strconv.Atoi(resp.Header.Get(paginatorHeaderPerPage)); err == nil {
+		info.perPage = perPage
+	}
+
+	if link := resp.Header.Get(paginatorHeaderLink); link != "" {
+		info.hasLink = true
+		for _, part := range strings.Split(link, ",") {
+			if strings.Contains(part, `rel="next"`) {
+				info.hasNext = true
+				break
+			}
+		}
+	}
+
+	return &info
+}
@@ -0,0 +1,405 @@
+// Package unsplashmock provides Mock, a hand-written implementation of
+// unsplash.Service for unit tests of code which depends on the client.
+package unsplashmock
+
+import (
+	"context"
+	"errors"
+	"github.com/kazhuravlev/go-unsplash/unsplash"
+	"io"
+	"sync"
+)
+
+// ErrNotMocked is returned by methods whose function field is not set.
+var ErrNotMocked = errors.New("unsplashmock: method is not mocked")
+
+var _ unsplash.Service = (*Mock)(nil)
+
+// Mock implements unsplash.Service. Every method calls the function field
+// with the same name and Func suffix, e.g. GetPhoto calls GetPhotoFunc. When
+// the field is nil the method returns ErrNotMocked. Mock is safe for
+// concurrent use as long as function fields are not changed.
+type Mock struct {
+	GetRandomPhotosFunc           func(ctx context.Context, opts unsplash.GetRandomPhotosOptions) ([]unsplash.Photo, *unsplash.RateLimit, error)
+	GetPhotosFunc                 func(ctx context.Context, opts unsplash.GetPhotosOptions) ([]unsplash.Photo, *unsplash.RateLimit, error)
+	GetCuratedPhotosFunc          func(ctx context.Context, opts unsplash.GetPhotosOptions) ([]unsplash.Photo, *unsplash.RateLimit, error)
+	GetPhotoFunc                  func(ctx context.Context, id string) (*unsplash.Photo, *unsplash.RateLimit, error)
+	GetPhotoStatisticsFunc        func(ctx context.Context, opts unsplash.GetPhotoStatisticsOptions) (*unsplash.PhotoStatistics, *unsplash.RateLimit, error)
+	GetPhotoDownloadFunc          func(ctx context.Context, id string) (*unsplash.PhotoDownload, *unsplash.RateLimit, error)
+	UpdatePhotoFunc               func(ctx context.Context, opts unsplash.UpdatePhotoOptions) (*unsplash.Photo, *unsplash.RateLimit, error)
+	LikePhotoFunc                 func(ctx context.Context, id string) (*unsplash.Photo, *unsplash.RateLimit, error)
+	UnlikePhotoFunc               func(ctx context.Context, id string) (*unsplash.Photo, *unsplash.RateLimit, error)
+	TrackDownloadFunc             func(ctx context.Context, photo *unsplash.Photo) (*unsplash.PhotoDownload, *unsplash.RateLimit, error)
+	DownloadPhotoFunc             func(ctx context.Context, photo *unsplash.Photo, w io.Writer) (*unsplash.RateLimit, error)
+	SearchPhotosFunc              func(ctx context.Context, opts unsplash.SearchPhotosOptions) (*unsplash.SearchResult, *unsplash.RateLimit, error)
+	SearchCollectionsFunc         func(ctx context.Context, opts unsplash.SearchCollectionsOptions) (*unsplash.CollectionSearchResult, *unsplash.RateLimit, error)
+	SearchUsersFunc               func(ctx context.Context, opts unsplash.SearchUsersOptions) (*unsplash.UsersSearchResult, *unsplash.RateLimit, error)
+	GetUserFunc                   func(ctx context.Context, username string) (*unsplash.User, *unsplash.RateLimit, error)
+	GetUserPortfolioFunc          func(ctx context.Context, username string) (*unsplash.UserPortfolio, *unsplash.RateLimit, error)
+	ListUserPhotosFunc            func(ctx context.Context, opts unsplash.ListUserPhotosOptions) ([]unsplash.Photo, *unsplash.RateLimit, error)
+	ListUserLikesFunc             func(ctx context.Context, opts unsplash.ListUserLikesOptions) ([]unsplash.Photo, *unsplash.RateLimit, error)
+	ListUserCollectionsFunc       func(ctx context.Context, opts unsplash.ListUserCollectionsOptions) ([]unsplash.Collection, *unsplash.RateLimit, error)
+	GetUserStatisticsFunc         func(ctx context.Context, opts unsplash.GetUserStatisticsOptions) (*unsplash.UserStatistics, *unsplash.RateLimit, error)
+	GetCurrentUserFunc            func(ctx context.Context) (*unsplash.CurrentUser, *unsplash.RateLimit, error)
+	UpdateCurrentUserFunc         func(ctx context.Context, opts unsplash.UpdateCurrentUserOptions) (*unsplash.CurrentUser, *unsplash.RateLimit, error)
+	ListCollectionsFunc           func(ctx context.Context, opts unsplash.ListCollectionsOptions) ([]unsplash.Collection, *unsplash.RateLimit, error)
+	GetCollectionFunc             func(ctx context.Context, id string) (*unsplash.Collection, *unsplash.RateLimit, error)
+	ListCollectionPhotosFunc      func(ctx context.Context, opts unsplash.ListCollectionPhotosOptions) ([]unsplash.Photo, *unsplash.RateLimit, error)
+	ListRelatedCollectionsFunc    func(ctx context.Context, id string) ([]unsplash.Collection, *unsplash.RateLimit, error)
+	CreateCollectionFunc          func(ctx context.Context, opts unsplash.CreateCollectionOptions) (*unsplash.Collection, *unsplash.RateLimit, error)
+	UpdateCollectionFunc          func(ctx context.Context, opts unsplash.UpdateCollectionOptions) (*unsplash.Collection, *unsplash.RateLimit, error)
+	DeleteCollectionFunc          func(ctx context.Context, id string) (*unsplash.RateLimit, error)
+	AddPhotoToCollectionFunc      func(ctx context.Context, opts unsplash.CollectionPhotoOptions) (*unsplash.CollectedPhoto, *unsplash.RateLimit, error)
+	RemovePhotoFromCollectionFunc func(ctx context.Context, opts unsplash.CollectionPhotoOptions) (*unsplash.CollectedPhoto, *unsplash.RateLimit, error)
+	ListTopicsFunc                func(ctx context.Context, opts unsplash.ListTopicsOptions) ([]unsplash.Topic, *unsplash.RateLimit, error)
+	GetTopicFunc                  func(ctx context.Context, idOrSlug string) (*unsplash.Topic, *unsplash.RateLimit, error)
+	ListTopicPhotosFunc           func(ctx context.Context, opts unsplash.ListTopicPhotosOptions) ([]unsplash.Photo, *unsplash.RateLimit, error)
+	GetTotalStatsFunc             func(ctx context.Context) (*unsplash.TotalStats, *unsplash.RateLimit, error)
+	GetMonthStatsFunc             func(ctx context.Context) (*unsplash.MonthStats, *unsplash.RateLimit, error)
+
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+// Calls returns the number of calls of the method with given name.
+func (m *Mock) Calls(method string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.calls[method]
+}
+
+func (m *Mock) called(method string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.calls == nil {
+		m.calls = make(map[string]int)
+	}
+
+	m.calls[method]++
+}
+
+func (m *Mock) GetRandomPhotos(ctx context.Context, opts unsplash.GetRandomPhotosOptions) ([]unsplash.Photo, *unsplash.RateLimit, error) {
+	m.called("GetRandomPhotos")
+	if m.GetRandomPhotosFunc == nil {
+		return nil, nil, ErrNotMocked
+	}
+
+	return m.GetRandomPhotosFunc(ctx, opts)
+}
+
+func (m *Mock) GetPhotos(ctx context.Context, opts unsplash.GetPhotosOptions) ([]unsplash.Photo, *unsplash.RateLimit, error) {
+	m.called("GetPhotos")
+	if m.GetPhotosFunc == nil {
+		return nil, nil, ErrNotMocked
+	}
+
+	return m.GetPhotosFunc(ctx, opts)
+}
+
+func (m *Mock) GetCuratedPhotos(ctx context.Context, opts unsplash.GetPhotosOptions) ([]unsplash.Photo, *unsplash.RateLimit, error) {
+	m.called("GetCuratedPhotos")
+	if m.GetCuratedPhotosFunc == nil {
+		return nil, nil, ErrNotMocked
+	}
+
+	return m.GetCuratedPhotosFunc(ctx, opts)
+}
+
+func (m *Mock) GetPhoto(ctx context.Context, id string) (*unsplash.Photo, *unsplash.RateLimit, error) {
+	m.called("GetPhoto")
+	if m.GetPhotoFunc == nil {
+		return nil, nil, ErrNotMocked
+	}
+
+	return m.GetPhotoFunc(ctx, id)
+}
+
+func (m *Mock) GetPhotoStatistics(ctx context.Context, opts unsplash.GetPhotoStatisticsOptions) (*unsplash.PhotoStatistics, *unsplash.RateLimit, error) {
+	m.called("GetPhotoStatistics")
+	if m.GetPhotoStatisticsFunc == nil {
+		return nil, nil, ErrNotMocked
+	}
+
+	return m.GetPhotoStatisticsFunc(ctx, opts)
+}
+
+func (m *Mock) GetPhotoDownload(ctx context.Context, id string) (*unsplash.PhotoDownload, *unsplash.RateLimit, error) {
+	m.called("GetPhotoDownload")
+	if m.GetPhotoDownloadFunc == nil {
+		return nil, nil, ErrNotMocked
+	}
+
+	return m.GetPhotoDownloadFunc(ctx, id)
+}
+
+func (m *Mock) UpdatePhoto(ctx context.Context, opts unsplash.UpdatePhotoOptions) (*unsplash.Photo, *unsplash.RateLimit, error) {
+	m.called("UpdatePhoto")
+	if m.UpdatePhotoFunc == nil {
+		return nil, nil, ErrNotMocked
+	}
+
+	return m.UpdatePhotoFunc(ctx, opts)
+}
+
+func (m *Mock) LikePhoto(ctx context.Context, id string) (*unsplash.Photo, *unsplash.RateLimit, error) {
+	m.called("LikePhoto")
+	if m.LikePhotoFunc == nil {
+		return nil, nil, ErrNotMocked
+	}
+
+	return m.LikePhotoFunc(ctx, id)
+}
+
+func (m *Mock) UnlikePhoto(ctx context.Context, id string) (*unsplash.Photo, *unsplash.RateLimit, error) {
+	m.called("UnlikePhoto")
+	if m.UnlikePhotoFunc == nil {
+		return nil, nil, ErrNotMocked
+	}
+
+	return m.UnlikePhotoFunc(ctx, id)
+}
+
+func (m *Mock) TrackDownload(ctx context.Context, photo *unsplash.Photo) (*unsplash.PhotoDownload, *unsplash.RateLimit, error) {
+	m.called("TrackDownload")
+	if m.TrackDownloadFunc == nil {
+		return nil, nil, ErrNotMocked
+	}
+
+	return m.TrackDownloadFunc(ctx, photo)
+}
+
+func (m *Mock) DownloadPhoto(ctx context.Context, photo *unsplash.Photo, w io.Writer) (*unsplash.RateLimit, error) {
+	m.called("DownloadPhoto")
+	if m.DownloadPhotoFunc == nil {
+		return nil, ErrNotMocked
+	}
+
+	return m.DownloadPhotoFunc(ctx, photo, w)
+}
+
+func (m *Mock) SearchPhotos(ctx context.Context, opts unsplash.SearchPhotosOptions) (*unsplash.SearchResult, *unsplash.RateLimit, error) {
+	m.called("SearchPhotos")
+	if m.SearchPhotosFunc == nil {
+		return nil, nil, ErrNotMocked
+	}
+
+	return m.SearchPhotosFunc(ctx, opts)
+}
+
+func (m *Mock) SearchCollections(ctx context.Context, opts unsplash.SearchCollectionsOptions) (*unsplash.CollectionSearchResult, *unsplash.RateLimit, error) {
+	m.called("SearchCollections")
+	if m.SearchCollectionsFunc == nil {
+		return nil, nil, ErrNotMocked
+	}
+
+	return m.SearchCollectionsFunc(ctx, opts)
+}
+
+func (m *Mock) SearchUsers(ctx context.Context, opts unsplash.SearchUsersOptions) (*unsplash.UsersSearchResult, *unsplash.RateLimit, error) {
+	m.called("SearchUsers")
+	if m.SearchUsersFunc == nil {
+		return nil, nil, ErrNotMocked
+	}
+
+	return m.SearchUsersFunc(ctx, opts)
+}
+
+func (m *Mock) GetUser(ctx context.Context, username string) (*unsplash.User, *unsplash.RateLimit, error) {
+	m.called("GetUser")
+	if m.GetUserFunc == nil {
+		return nil, nil, ErrNotMocked
+	}
+
+	return m.GetUserFunc(ctx, username)
+}
+
+func (m *Mock) GetUserPortfolio(ctx context.Context, username string) (*unsplash.UserPortfolio, *unsplash.RateLimit, error) {
+	m.called("GetUserPortfolio")
+	if m.GetUserPortfolioFunc == nil {
+		return nil, nil, ErrNotMocked
+	}
+
+	return m.GetUserPortfolioFunc(ctx, username)
+}
+
+func (m *Mock) ListUserPhotos(ctx context.Context, opts unsplash.ListUserPhotosOptions) ([]unsplash.Photo, *unsplash.RateLimit, error) {
+	m.called("ListUserPhotos")
+	if m.ListUserPhotosFunc == nil {
+		return nil, nil, ErrNotMocked
+	}
+
+	return m.ListUserPhotosFunc(ctx, opts)
+}
+
+func (m *Mock) ListUserLikes(ctx context.Context, opts unsplash.ListUserLikesOptions) ([]unsplash.Photo, *unsplash.RateLimit, error) {
+	m.called("ListUserLikes")
+	if m.ListUserLikesFunc == nil {
+		return nil, nil, ErrNotMocked
+	}
+
+	return m.ListUserLikesFunc(ctx, opts)
+}
+
+func (m *Mock) ListUserCollections(ctx context.Context, opts unsplash.ListUserCollectionsOptions) ([]unsplash.Collection, *unsplash.RateLimit, error) {
+	m.called("ListUserCollections")
+	if m.ListUserCollectionsFunc == nil {
+		return nil, nil, ErrNotMocked
+	}
+
+	return m.ListUserCollectionsFunc(ctx, opts)
+}
+
+func (m *Mock) GetUserStatistics(ctx context.Context, opts unsplash.GetUserStatisticsOptions) (*unsplash.UserStatistics, *unsplash.RateLimit, error) {
+	m.called("GetUserStatistics")
+	if m.GetUserStatisticsFunc == nil {
+		return nil, nil, ErrNotMocked
+	}
+
+	return m.GetUserStatisticsFunc(ctx, opts)
+}
+
+func (m *Mock) GetCurrentUser(ctx context.Context) (*unsplash.CurrentUser, *unsplash.RateLimit, error) {
+	m.called("GetCurrentUser")
+	if m.GetCurrentUserFunc == nil {
+		return nil, nil, ErrNotMocked
+	}
+
+	return m.GetCurrentUserFunc(ctx)
+}
+
+func (m *Mock) UpdateCurrentUser(ctx context.Context, opts unsplash.UpdateCurrentUserOptions) (*unsplash.CurrentUser, *unsplash.RateLimit, error) {
+	m.called("UpdateCurrentUser")
+	if m.UpdateCurrentUserFunc == nil {
+		return nil, nil, ErrNotMocked
+	}
+
+	return m.UpdateCurrentUserFunc(ctx, opts)
+}
+
+func (m *Mock) ListCollections(ctx context.Context, opts unsplash.ListCollectionsOptions) ([]unsplash.Collection, *unsplash.RateLimit, error) {
+	m.called("ListCollections")
+	if m.ListCollectionsFunc == nil {
+		return nil, nil, ErrNotMocked
+	}
+
+	return m.ListCollectionsFunc(ctx, opts)
+}
+
+func (m *Mock) GetCollection(ctx context.Context, id string) (*unsplash.Collection, *unsplash.RateLimit, error) {
+	m.called("GetCollection")
+	if m.GetCollectionFunc == nil {
+		return nil, nil, ErrNotMocked
+	}
+
+	return m.GetCollectionFunc(ctx, id)
+}
+
+func (m *Mock) ListCollectionPhotos(ctx context.Context, opts unsplash.ListCollectionPhotosOptions) ([]unsplash.Photo, *unsplash.RateLimit, error) {
+	m.called("ListCollectionPhotos")
+	if m.ListCollectionPhotosFunc == nil {
+		return nil, nil, ErrNotMocked
+	}
+
+	return m.ListCollectionPhotosFunc(ctx, opts)
+}
+
+func (m *Mock) ListRelatedCollections(ctx context.Context, id string) ([]unsplash.Collection, *unsplash.RateLimit, error) {
+	m.called("ListRelatedCollections")
+	if m.ListRelatedCollectionsFunc == nil {
+		return nil, nil, ErrNotMocked
+	}
+
+	return m.ListRelatedCollectionsFunc(ctx, id)
+}
+
+func (m *Mock) CreateCollection(ctx context.Context, opts unsplash.CreateCollectionOptions) (*unsplash.Collection, *unsplash.RateLimit, error) {
+	m.called("CreateCollection")
+	if m.CreateCollectionFunc == nil {
+		return nil, nil, ErrNotMocked
+	}
+
+	return m.CreateCollectionFunc(ctx, opts)
+}
+
+func (m *Mock) UpdateCollection(ctx context.Context, opts unsplash.UpdateCollectionOptions) (*unsplash.Collection, *unsplash.RateLimit, error) {
+	m.called("UpdateCollection")
+	if m.UpdateCollectionFunc == nil {
+		return nil, nil, ErrNotMocked
+	}
+
+	return m.UpdateCollectionFunc(ctx, opts)
+}
+
+func (m *Mock) DeleteCollection(ctx context.Context, id string) (*unsplash.RateLimit, error) {
+	m.called("DeleteCollection")
+	if m.DeleteCollectionFunc == nil {
+		return nil, ErrNotMocked
+	}
+
+	return m.DeleteCollectionFunc(ctx, id)
+}
+
+func (m *Mock) AddPhotoToCollection(ctx context.Context, opts unsplash.CollectionPhotoOptions) (*unsplash.CollectedPhoto, *unsplash.RateLimit, error) {
+	m.called("AddPhotoToCollection")
+	if m.AddPhotoToCollectionFunc == nil {
+		return nil, nil, ErrNotMocked
+	}
+
+	return m.AddPhotoToCollectionFunc(ctx, opts)
+}
+
+func (m *Mock) RemovePhotoFromCollection(ctx context.Context, opts unsplash.CollectionPhotoOptions) (*unsplash.CollectedPhoto, *unsplash.RateLimit, error) {
+	m.called("RemovePhotoFromCollection")
+	if m.RemovePhotoFromCollectionFunc == nil {
+		return nil, nil, ErrNotMocked
+	}
+
+	return m.RemovePhotoFromCollectionFunc(ctx, opts)
+}
+
+func (m *Mock) ListTopics(ctx context.Context, opts unsplash.ListTopicsOptions) ([]unsplash.Topic, *unsplash.RateLimit, error) {
+	m.called("ListTopics")
+	if m.ListTopicsFunc == nil {
+		return nil, nil, ErrNotMocked
+	}
+
+	return m.ListTopicsFunc(ctx, opts)
+}
+
+func (m *Mock) GetTopic(ctx context.Context, idOrSlug string) (*unsplash.Topic, *unsplash.RateLimit, error) {
+	m.called("GetTopic")
+	if m.GetTopicFunc == nil {
+		return nil, nil, ErrNotMocked
+	}
+
+	return m.GetTopicFunc(ctx, idOrSlug)
+}
+
+func (m *Mock) ListTopicPhotos(ctx context.Context, opts unsplash.ListTopicPhotosOptions) ([]unsplash.Photo, *unsplash.RateLimit, error) {
+	m.called("ListTopicPhotos")
+	if m.ListTopicPhotosFunc == nil {
+		return nil, nil, ErrNotMocked
+	}
+
+	return m.ListTopicPhotosFunc(ctx, opts)
+}
+
+func (m *Mock) GetTotalStats(ctx context.Context) (*unsplash.TotalStats, *unsplash.RateLimit, error) {
+	m.called("GetTotalStats")
+	if m.GetTotalStatsFunc == nil {
+		return nil, nil, ErrNotMocked
+	}
+
+	return m.GetTotalStatsFunc(ctx)
+}
+
+func (m *Mock) GetMonthStats(ctx context.Context) (*unsplash.MonthStats, *unsplash.RateLimit, error) {
+	m.called("GetMonthStats")
+	if m.GetMonthStatsFunc == nil {
+		return nil, nil, ErrNotMocked
+	}
+
+	return m.GetMonthStatsFunc(ctx)
+}
@@ -0,0 +1,48 @@
+package unsplashmock_test
+
+import (
+	"context"
+	"errors"
+	"github.com/kazhuravlev/go-unsplash/unsplash"
+	"github.com/kazhuravlev/go-unsplash/unsplash/unsplashmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+// photoTitle is an example of downstream code which depends on the interface.
+func photoTitle(ctx context.Context, photos unsplash.PhotosService, id string) (string, error) {
+	photo, _, err := photos.GetPhoto(ctx, id)
+	if err != nil {
+		return "", err
+	}
+
+	return photo.Description + " by " + photo.User.Name, nil
+}
+
+func TestMock(t *testing.T) {
+	ctx := context.Background()
+
+	m := &unsplashmock.Mock{
+		GetPhotoFunc: func(ctx context.Context, id string) (*unsplash.Photo, *unsplash.RateLimit, error) {
+			if id != "abc" {
+				return nil, nil, unsplash.ErrNotFound
+			}
+
+			return &unsplash.Photo{ID: id, Description: "red car", User: unsplash.User{Name: "John"}}, nil, nil
+		},
+	}
+
+	title, err := photoTitle(ctx, m, "abc")
+	require.Nil(t, err)
+	assert.Equal(t, "red car by John", title)
+
+	_, err = photoTitle(ctx, m, "xyz")
+	assert.True(t, errors.Is(err, unsplash.ErrNotFound))
+	assert.Equal(t, 2, m.Calls("GetPhoto"))
+
+	_, _, err = m.SearchPhotos(ctx, unsplash.SearchPhotosOptions{Query: "car"})
+	assert.True(t, errors.Is(err, unsplashmock.ErrNotMocked))
+	assert.Equal(t, 1, m.Calls("SearchPhotos"))
+	assert.Equal(t, 0, m.Calls("GetUser"))
+}
@@ -0,0 +1,247 @@
+package unsplashtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Mode is the mode of Recorder.
+type Mode int
+
+const (
+	// ModeReplay serves responses from the cassette file without network.
+	ModeReplay Mode = iota
+	// ModeRecord sends requests to the base transport and records them.
+	ModeRecord
+)
+
+// ErrNoInteraction is returned in replay mode when the cassette has no
+// recorded interaction for a request.
+var ErrNoInteraction = errors.New("unsplashtest: no recorded interaction")
+
+// scrubbedHeaders are never written to cassettes.
+var scrubbedHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+// scrubbedParams are removed from recorded URLs.
+var scrubbedParams = []string{"client_id", "access_token"}
+
+// Cassette is a set of recorded HTTP interactions.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest identifies a request. URL has credentials scrubbed.
+type RecordedRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+}
+
+// RecordedResponse is a recorded response without credential headers.
+type RecordedResponse struct {
+	Status  int         `json:"status"`
+	Headers http.Header `json:"headers"`
+	// Body is set when response body is valid JSON, BodyText otherwise.
+	Body     json.RawMessage `json:"body,omitempty"`
+	BodyText string          `json:"body_text,omitempty"`
+}
+
+// Recorder is a cassette-style http.RoundTripper. In record mode it sends
+// requests to the base transport and records interactions, which are written
+// by Save with credentials scrubbed. In replay mode it serves recorded
+// responses in order, matching requests by method and URL.
+//
+// To keep Authorization header out of cassettes place Recorder below the
+// transport which sets it, e.g. as oauth2.Transport.Base.
+type Recorder struct {
+	path string
+	mode Mode
+	base http.RoundTripper
+
+	mu       sync.Mutex
+	cassette Cassette
+	used     []bool
+}
+
+// NewRecorder creates a recorder for the cassette file at path. In replay
+// mode the file must exist. base is used in record mode; nil means
+// http.DefaultTransport.
+func NewRecorder(path string, mode Mode, base http.RoundTripper) (*Recorder, error) {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	r := Recorder{
+		path: path,
+		mode: mode,
+		base: base,
+	}
+
+	if mode == ModeReplay {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(data, &r.cassette); err != nil {
+			return nil, fmt.Errorf("unsplashtest: bad cassette %s: %v", path, err)
+		}
+
+		r.used = make([]bool, len(r.cassette.Interactions))
+	}
+
+	return &r, nil
+}
+
+// Client returns http client which uses the recorder as transport.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	if r.mode == ModeRecord {
+		return r.record(req)
+	}
+
+	return r.replay(req)
+}
+
+func (r *Recorder) record(req *http.Request) (*http.Response, error) {
+	resp, err := r.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	recorded := RecordedResponse{
+		Status:  resp.StatusCode,
+		Headers: resp.Header.Clone(),
+	}
+
+	for _, h := range scrubbedHeaders {
+		recorded.Headers.Del(h)
+	}
+
+	if json.Valid(body) {
+		recorded.Body = body
+	} else {
+		recorded.BodyText = string(body)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			URL:    scrubURL(req.URL),
+		},
+		Response: recorded,
+	})
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	u := scrubURL(req.URL)
+
+	// prefer the first unused interaction, then reuse the last matching one
+	match := -1
+	for i, interaction := range r.cassette.Interactions {
+		if interaction.Request.Method != req.Method || interaction.Request.URL != u {
+			continue
+		}
+
+		match = i
+		if !r.used[i] {
+			break
+		}
+	}
+
+	if match == -1 {
+		return nil, fmt.Errorf("%w: %s %s", ErrNoInteraction, req.Method, u)
+	}
+
+	r.used[match] = true
+	recorded := r.cassette.Interactions[match].Response
+
+	body := []byte(recorded.Body)
+	if recorded.BodyText != "" {
+		body = []byte(recorded.BodyText)
+	}
+
+	header := recorded.Headers.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.Status, http.StatusText(recorded.Status)),
+		StatusCode:    recorded.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// Save writes recorded interactions to the cassette file. It does nothing in
+// replay mode.
+func (r *Recorder) Save() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(r.path, append(data, '\n'), 0644)
+}
+
+func scrubURL(u *url.URL) string {
+	scrubbed := *u
+	q := scrubbed.Query()
+	for _, p := range scrubbedParams {
+		q.Del(p)
+	}
+	scrubbed.RawQuery = q.Encode()
+	scrubbed.User = nil
+
+	return scrubbed.String()
+}
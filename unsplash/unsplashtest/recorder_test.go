@@ -0,0 +1,90 @@
+package unsplashtest_test
+
+import (
+	"context"
+	"errors"
+	"github.com/kazhuravlev/go-unsplash/unsplash"
+	"github.com/kazhuravlev/go-unsplash/unsplash/unsplashtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// authTransport emulates oauth2.Transport placed above the recorder.
+type authTransport struct {
+	base http.RoundTripper
+}
+
+func (t authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer secret-token")
+
+	return t.base.RoundTrip(req)
+}
+
+func TestRecorder(t *testing.T) {
+	srv, _ := newServer(t)
+	defer srv.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	ctx := context.Background()
+
+	rec, err := unsplashtest.NewRecorder(path, unsplashtest.ModeRecord, nil)
+	require.Nil(t, err)
+
+	c, err := unsplash.New(
+		unsplash.WithHttpClient(&http.Client{Transport: authTransport{base: rec}}),
+		unsplash.WithBaseURL(srv.URL),
+	)
+	require.Nil(t, err)
+
+	photo, _, err := c.GetPhoto(ctx, "p1")
+	require.Nil(t, err)
+
+	req, err := http.NewRequest(http.MethodGet, "/photos/p2?client_id=secret-key", nil)
+	require.Nil(t, err)
+	_, err = c.Do(ctx, req, nil)
+	require.Nil(t, err)
+
+	_, _, err = c.GetPhoto(ctx, "missing")
+	require.True(t, errors.Is(err, unsplash.ErrNotFound))
+
+	require.Nil(t, rec.Save())
+
+	data, err := os.ReadFile(path)
+	require.Nil(t, err)
+	assert.NotContains(t, string(data), "secret-token")
+	assert.NotContains(t, string(data), "secret-key")
+
+	srv.Close()
+
+	replay, err := unsplashtest.NewRecorder(path, unsplashtest.ModeReplay, nil)
+	require.Nil(t, err)
+
+	c, err = unsplash.New(unsplash.WithHttpClient(replay.Client()), unsplash.WithBaseURL(srv.URL))
+	require.Nil(t, err)
+
+	replayed, rl, err := c.GetPhoto(ctx, "p1")
+	require.Nil(t, err)
+	assert.Equal(t, photo, replayed)
+	assert.NotNil(t, rl)
+
+	req, err = http.NewRequest(http.MethodGet, "/photos/p2?client_id=other-key", nil)
+	require.Nil(t, err)
+	_, err = c.Do(ctx, req, nil)
+	require.Nil(t, err)
+
+	_, _, err = c.GetPhoto(ctx, "missing")
+	assert.True(t, errors.Is(err, unsplash.ErrNotFound))
+
+	_, _, err = c.GetPhoto(ctx, "p3")
+	assert.True(t, errors.Is(err, unsplashtest.ErrNoInteraction))
+}
+
+func TestNewRecorder_MissingCassette(t *testing.T) {
+	_, err := unsplashtest.NewRecorder(filepath.Join(t.TempDir(), "none.json"), unsplashtest.ModeReplay, nil)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,696 @@
+// Package unsplashtest provides a fake Unsplash API server for offline tests.
+//
+// The server is backed by an in-memory fixture store, emits rate limit and
+// pagination headers like the real API and supports injected errors:
+//
+//	srv := unsplashtest.NewServer()
+//	defer srv.Close()
+//
+//	srv.AddPhotos(unsplash.Photo{ID: "abc"})
+//	srv.InjectError(unsplashtest.Error{Method: http.MethodGet, Path: "/photos/def", Status: http.StatusNotFound})
+//
+//	client, err := srv.Client()
+package unsplashtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/kazhuravlev/go-unsplash/unsplash"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	defaultRateLimit = 5000
+	defaultPerPage   = 10
+	maxPerPage       = 30
+)
+
+// Error is a response injected into the server instead of a regular one.
+type Error struct {
+	// Method and Path select requests to fail. Empty Method matches any method.
+	Method string
+	Path   string
+	// Status is the response status code.
+	Status int
+	// Messages are sent in the "errors" field of the response body.
+	Messages []string
+	// Times limits how many requests fail; 0 means every request.
+	Times int
+}
+
+// Server is a fake Unsplash API server.
+type Server struct {
+	*httptest.Server
+
+	mu               sync.Mutex
+	photos           []unsplash.Photo
+	users            []unsplash.User
+	collections      []unsplash.Collection
+	collectionPhotos map[string][]string
+	likes            map[string][]string
+	errors           []*Error
+	limit            int
+	remaining        int
+	requests         int
+	nextCollectionID int
+}
+
+// NewServer starts a fake server with an empty fixture store.
+func NewServer() *Server {
+	s := Server{
+		collectionPhotos: make(map[string][]string),
+		likes:            make(map[string][]string),
+		limit:            defaultRateLimit,
+		remaining:        defaultRateLimit,
+		nextCollectionID: 1,
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return &s
+}
+
+// Client returns an unsplash client pointed at the server. Given options are
+// applied after the base URL option.
+func (s *Server) Client(options ...unsplash.Option) (*unsplash.Client, error) {
+	return unsplash.New(append([]unsplash.Option{unsplash.WithBaseURL(s.URL)}, options...)...)
+}
+
+// AddPhotos adds photos to the store. Photo authors are added as users.
+func (s *Server) AddPhotos(photos ...unsplash.Photo) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, photo := range photos {
+		s.photos = append(s.photos, photo)
+		if photo.User.Username != "" && s.user(photo.User.Username) == nil {
+			s.users = append(s.users, photo.User)
+		}
+	}
+}
+
+// AddUsers adds users to the store.
+func (s *Server) AddUsers(users ...unsplash.User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.users = append(s.users, users...)
+}
+
+// AddCollections adds collections to the store.
+func (s *Server) AddCollections(collections ...unsplash.Collection) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.collections = append(s.collections, collections...)
+}
+
+// AddCollectionPhotos puts photos into the collection.
+func (s *Server) AddCollectionPhotos(collectionID string, photoIDs ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.collectionPhotos[collectionID] = append(s.collectionPhotos[collectionID], photoIDs...)
+}
+
+// AddLikes marks photos as liked by the user.
+func (s *Server) AddLikes(username string, photoIDs ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.likes[username] = append(s.likes[username], photoIDs...)
+}
+
+// InjectError makes matching requests fail.
+func (s *Server) InjectError(e Error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.errors = append(s.errors, &e)
+}
+
+// SetRateLimit sets the hourly limit and the remaining quota. When the quota
+// is exhausted the server responds 403 like the real API.
+func (s *Server) SetRateLimit(limit, remaining int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.limit = limit
+	s.remaining = remaining
+}
+
+// Requests returns the number of received requests.
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.requests
+}
+
+// Photo returns the stored photo.
+func (s *Server) Photo(id string) (unsplash.Photo, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if photo := s.photo(id); photo != nil {
+		return *photo, true
+	}
+
+	return unsplash.Photo{}, false
+}
+
+// Collection returns the stored collection.
+func (s *Server) Collection(id string) (unsplash.Collection, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if collection := s.collection(id); collection != nil {
+		return *collection, true
+	}
+
+	return unsplash.Collection{}, false
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests++
+
+	if s.remaining > 0 {
+		s.remaining--
+	} else {
+		s.writeLimits(w)
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte("Rate Limit Exceeded"))
+		return
+	}
+
+	s.writeLimits(w)
+
+	if e := s.injectedError(r); e != nil {
+		writeError(w, e.Status, e.Messages...)
+		return
+	}
+
+	s.route(w, r)
+}
+
+func (s *Server) writeLimits(w http.ResponseWriter) {
+	w.Header().Set("X-Ratelimit-Limit", strconv.Itoa(s.limit))
+	w.Header().Set("X-Ratelimit-Remaining", strconv.Itoa(s.remaining))
+}
+
+func (s *Server) injectedError(r *http.Request) *Error {
+	for i, e := range s.errors {
+		if e.Path != r.URL.Path || (e.Method != "" && e.Method != r.Method) {
+			continue
+		}
+
+		if e.Times > 0 {
+			e.Times--
+			if e.Times == 0 {
+				s.errors = append(s.errors[:i], s.errors[i+1:]...)
+			}
+		}
+
+		return e
+	}
+
+	return nil
+}
+
+func (s *Server) route(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	route := r.Method + " /" + parts[0]
+	if len(parts) > 1 {
+		route += "/:id"
+	}
+	if len(parts) > 2 {
+		route += "/" + strings.Join(parts[2:], "/")
+	}
+
+	switch {
+	case r.URL.Path == "/photos/random" && r.Method == http.MethodGet:
+		s.randomPhotos(w, r)
+	case r.URL.Path == "/photos/curated" && r.Method == http.MethodGet:
+		s.writePage(w, r, s.photos)
+	case parts[0] == "search" && len(parts) == 2 && r.Method == http.MethodGet:
+		s.search(w, r, parts[1])
+	case route == "GET /stats/:id" && parts[1] == "total":
+		writeJSON(w, http.StatusOK, unsplash.TotalStats{Photos: int64(len(s.photos)), Photographers: int64(len(s.users))})
+	case route == "GET /stats/:id" && parts[1] == "month":
+		writeJSON(w, http.StatusOK, unsplash.MonthStats{NewPhotos: int64(len(s.photos)), NewPhotographers: int64(len(s.users))})
+	case route == "GET /photos":
+		s.writePage(w, r, s.photos)
+	case route == "GET /photos/:id":
+		s.withPhoto(w, parts[1], func(photo *unsplash.Photo) {
+			writeJSON(w, http.StatusOK, photo)
+		})
+	case route == "PUT /photos/:id":
+		s.withPhoto(w, parts[1], func(photo *unsplash.Photo) {
+			updatePhoto(photo, r.URL.Query())
+			writeJSON(w, http.StatusOK, photo)
+		})
+	case route == "GET /photos/:id/statistics":
+		s.withPhoto(w, parts[1], func(photo *unsplash.Photo) {
+			writeJSON(w, http.StatusOK, unsplash.PhotoStatistics{ID: photo.ID})
+		})
+	case route == "GET /photos/:id/download":
+		s.withPhoto(w, parts[1], func(photo *unsplash.Photo) {
+			writeJSON(w, http.StatusOK, unsplash.PhotoDownload{URL: photo.Urls.Full})
+		})
+	case route == "POST /photos/:id/like":
+		s.withPhoto(w, parts[1], func(photo *unsplash.Photo) {
+			photo.LikedByUser = true
+			photo.Likes++
+			writeJSON(w, http.StatusCreated, photo)
+		})
+	case route == "DELETE /photos/:id/like":
+		s.withPhoto(w, parts[1], func(photo *unsplash.Photo) {
+			photo.LikedByUser = false
+			photo.Likes--
+			writeJSON(w, http.StatusOK, photo)
+		})
+	case strings.HasPrefix(route, "GET /users/:id"):
+		s.routeUser(w, r, parts)
+	case strings.HasPrefix(route, r.Method+" /collections"):
+		s.routeCollections(w, r, route, parts)
+	default:
+		writeError(w, http.StatusNotFound, "Couldn't find resource")
+	}
+}
+
+func (s *Server) routeUser(w http.ResponseWriter, r *http.Request, parts []string) {
+	user := s.user(parts[1])
+	if user == nil {
+		writeError(w, http.StatusNotFound, "Couldn't find User")
+		return
+	}
+
+	if len(parts) == 2 {
+		writeJSON(w, http.StatusOK, user)
+		return
+	}
+
+	switch strings.Join(parts[2:], "/") {
+	case "photos":
+		var photos []unsplash.Photo
+		for _, photo := range s.photos {
+			if photo.User.Username == user.Username {
+				photos = append(photos, photo)
+			}
+		}
+		s.writePage(w, r, photos)
+	case "likes":
+		var photos []unsplash.Photo
+		for _, id := range s.likes[user.Username] {
+			if photo := s.photo(id); photo != nil {
+				photos = append(photos, *photo)
+			}
+		}
+		s.writePage(w, r, photos)
+	case "collections":
+		var collections []unsplash.Collection
+		for _, collection := range s.collections {
+			if collection.User.Username == user.Username {
+				collections = append(collections, collection)
+			}
+		}
+		s.writePage(w, r, collections)
+	case "portfolio":
+		writeJSON(w, http.StatusOK, unsplash.UserPortfolio{URL: user.PortfolioURL})
+	case "statistics":
+		writeJSON(w, http.StatusOK, unsplash.UserStatistics{Username: user.Username})
+	default:
+		writeError(w, http.StatusNotFound, "Couldn't find resource")
+	}
+}
+
+func (s *Server) routeCollections(w http.ResponseWriter, r *http.Request, route string, parts []string) {
+	if route == "GET /collections" {
+		s.writePage(w, r, s.collections)
+		return
+	}
+
+	if route == "POST /collections" {
+		q := r.URL.Query()
+		if q.Get("title") == "" {
+			writeError(w, http.StatusUnprocessableEntity, "Title can't be blank")
+			return
+		}
+
+		collection := unsplash.Collection{
+			ID:          s.newCollectionID(),
+			Title:       q.Get("title"),
+			Description: q.Get("description"),
+			Private:     q.Get("private") == "true",
+		}
+		s.collections = append(s.collections, collection)
+		writeJSON(w, http.StatusCreated, collection)
+		return
+	}
+
+	collection := s.collection(parts[1])
+	if collection == nil {
+		writeError(w, http.StatusNotFound, "Couldn't find Collection")
+		return
+	}
+
+	switch route {
+	case "GET /collections/:id":
+		writeJSON(w, http.StatusOK, collection)
+	case "PUT /collections/:id":
+		q := r.URL.Query()
+		if title := q.Get("title"); title != "" {
+			collection.Title = title
+		}
+		if description := q.Get("description"); description != "" {
+			collection.Description = description
+		}
+		if private := q.Get("private"); private != "" {
+			collection.Private = private == "true"
+		}
+		writeJSON(w, http.StatusOK, collection)
+	case "DELETE /collections/:id":
+		for i := range s.collections {
+			if s.collections[i].ID == collection.ID {
+				s.collections = append(s.collections[:i], s.collections[i+1:]...)
+				break
+			}
+		}
+		delete(s.collectionPhotos, collection.ID)
+		w.WriteHeader(http.StatusNoContent)
+	case "GET /collections/:id/photos":
+		var photos []unsplash.Photo
+		for _, id := range s.collectionPhotos[collection.ID] {
+			if photo := s.photo(id); photo != nil {
+				photos = append(photos, *photo)
+			}
+		}
+		s.writePage(w, r, photos)
+	case "GET /collections/:id/related":
+		var related []unsplash.Collection
+		for _, c := range s.collections {
+			if c.ID != collection.ID {
+				related = append(related, c)
+			}
+		}
+		writeJSON(w, http.StatusOK, related)
+	case "POST /collections/:id/add", "DELETE /collections/:id/remove":
+		photo := s.photo(r.URL.Query().Get("photo_id"))
+		if photo == nil {
+			writeError(w, http.StatusNotFound, "Couldn't find Photo")
+			return
+		}
+
+		status := http.StatusOK
+		if r.Method == http.MethodPost {
+			status = http.StatusCreated
+			s.collectionPhotos[collection.ID] = append(s.collectionPhotos[collection.ID], photo.ID)
+			collection.TotalPhotos++
+		} else {
+			ids := s.collectionPhotos[collection.ID]
+			for i, id := range ids {
+				if id == photo.ID {
+					s.collectionPhotos[collection.ID] = append(ids[:i], ids[i+1:]...)
+					collection.TotalPhotos--
+					break
+				}
+			}
+		}
+
+		writeJSON(w, status, unsplash.CollectedPhoto{Photo: *photo, Collection: *collection, User: collection.User})
+	default:
+		writeError(w, http.StatusNotFound, "Couldn't find resource")
+	}
+}
+
+func (s *Server) randomPhotos(w http.ResponseWriter, r *http.Request) {
+	count := 1
+	if v := r.URL.Query().Get("count"); v != "" {
+		var err error
+		count, err = strconv.Atoi(v)
+		if err != nil || count < 1 || count > maxPerPage {
+			writeError(w, http.StatusBadRequest, "count is invalid")
+			return
+		}
+	}
+
+	photos := s.photos
+	if query := r.URL.Query().Get("query"); query != "" {
+		photos = filterPhotos(photos, query)
+	}
+
+	if count > len(photos) {
+		count = len(photos)
+	}
+
+	writeJSON(w, http.StatusOK, photos[:count])
+}
+
+func (s *Server) search(w http.ResponseWriter, r *http.Request, kind string) {
+	query := r.URL.Query().Get("query")
+	if query == "" {
+		writeError(w, http.StatusBadRequest, "query is missing")
+		return
+	}
+
+	page, perPage := pagination(r)
+
+	switch kind {
+	case "photos":
+		var results []unsplash.SearchPhoto
+		for _, photo := range filterPhotos(s.photos, query) {
+			results = append(results, unsplash.SearchPhoto{Photo: photo})
+		}
+		start, end := pageBounds(page, perPage, len(results))
+		writeJSON(w, http.StatusOK, unsplash.SearchResult{
+			Total:      len(results),
+			TotalPages: totalPages(len(results), perPage),
+			Results:    results[start:end],
+		})
+	case "collections":
+		var results []unsplash.Collection
+		for _, collection := range s.collections {
+			if contains(query, collection.Title, collection.Description) {
+				results = append(results, collection)
+			}
+		}
+		start, end := pageBounds(page, perPage, len(results))
+		writeJSON(w, http.StatusOK, unsplash.CollectionSearchResult{
+			Total:      len(results),
+			TotalPages: totalPages(len(results), perPage),
+			Results:    results[start:end],
+		})
+	case "users":
+		var results []unsplash.User
+		for _, user := range s.users {
+			if contains(query, user.Username, user.Name) {
+				results = append(results, user)
+			}
+		}
+		start, end := pageBounds(page, perPage, len(results))
+		writeJSON(w, http.StatusOK, unsplash.UsersSearchResult{
+			Total:      len(results),
+			TotalPages: totalPages(len(results), perPage),
+			Results:    results[start:end],
+		})
+	default:
+		writeError(w, http.StatusNotFound, "Couldn't find resource")
+	}
+}
+
+// writePage writes a page of items (a slice) with X-Total, X-Per-Page and
+// Link headers.
+func (s *Server) writePage(w http.ResponseWriter, r *http.Request, items interface{}) {
+	var all []json.RawMessage
+	data, _ := json.Marshal(items)
+	json.Unmarshal(data, &all)
+
+	page, perPage := pagination(r)
+	start, end := pageBounds(page, perPage, len(all))
+	last := totalPages(len(all), perPage)
+
+	w.Header().Set("X-Total", strconv.Itoa(len(all)))
+	w.Header().Set("X-Per-Page", strconv.Itoa(perPage))
+
+	links := []string{s.link(r, 1, "first")}
+	if page > 1 {
+		links = append(links, s.link(r, page-1, "prev"))
+	}
+	if page < last {
+		links = append(links, s.link(r, page+1, "next"))
+	}
+	links = append(links, s.link(r, last, "last"))
+	w.Header().Set("Link", strings.Join(links, ", "))
+
+	if all == nil {
+		all = []json.RawMessage{}
+	}
+
+	writeJSON(w, http.StatusOK, all[start:end])
+}
+
+func (s *Server) link(r *http.Request, page int, rel string) string {
+	q := r.URL.Query()
+	q.Set("page", strconv.Itoa(page))
+
+	u := url.URL{Path: r.URL.Path, RawQuery: q.Encode()}
+
+	return fmt.Sprintf(`<%s%s>; rel="%s"`, s.URL, u.String(), rel)
+}
+
+func (s *Server) withPhoto(w http.ResponseWriter, id string, f func(photo *unsplash.Photo)) {
+	photo := s.photo(id)
+	if photo == nil {
+		writeError(w, http.StatusNotFound, "Couldn't find Photo")
+		return
+	}
+
+	f(photo)
+}
+
+func (s *Server) photo(id string) *unsplash.Photo {
+	for i := range s.photos {
+		if s.photos[i].ID == id {
+			return &s.photos[i]
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) user(username string) *unsplash.User {
+	for i := range s.users {
+		if s.users[i].Username == username {
+			return &s.users[i]
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) collection(id string) *unsplash.Collection {
+	for i := range s.collections {
+		if s.collections[i].ID == id {
+			return &s.collections[i]
+		}
+	}
+
+	return nil
+}
+
+// newCollectionID returns the next numeric ID which is not taken yet.
+func (s *Server) newCollectionID() string {
+	for {
+		id := strconv.Itoa(s.nextCollectionID)
+		s.nextCollectionID++
+		if s.collection(id) == nil {
+			return id
+		}
+	}
+}
+
+func updatePhoto(photo *unsplash.Photo, q url.Values) {
+	if v := q.Get("location[name]"); v != "" {
+		photo.Location.Name = v
+	}
+	if v := q.Get("location[city]"); v != "" {
+		photo.Location.City = v
+	}
+	if v := q.Get("location[country]"); v != "" {
+		photo.Location.Country = v
+	}
+	if v, err := strconv.ParseFloat(q.Get("location[latitude]"), 64); err == nil {
+		photo.Location.Position.Latitude = v
+	}
+	if v, err := strconv.ParseFloat(q.Get("location[longitude]"), 64); err == nil {
+		photo.Location.Position.Longitude = v
+	}
+	if v := q.Get("exif[make]"); v != "" {
+		photo.Exif.Make = v
+	}
+	if v := q.Get("exif[models]"); v != "" {
+		photo.Exif.Model = v
+	}
+}
+
+func filterPhotos(photos []unsplash.Photo, query string) []unsplash.Photo {
+	var res []unsplash.Photo
+	for _, photo := range photos {
+		if contains(query, photo.Description, photo.Slug, photo.Location.Name) {
+			res = append(res, photo)
+		}
+	}
+
+	return res
+}
+
+// contains reports whether any of fields contains query, ignoring case.
+func contains(query string, fields ...string) bool {
+	query = strings.ToLower(query)
+	for _, field := range fields {
+		if strings.Contains(strings.ToLower(field), query) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func pagination(r *http.Request) (int, int) {
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	perPage, err := strconv.Atoi(r.URL.Query().Get("per_page"))
+	if err != nil || perPage < 1 {
+		perPage = defaultPerPage
+	}
+
+	if perPage > maxPerPage {
+		perPage = maxPerPage
+	}
+
+	return page, perPage
+}
+
+func pageBounds(page, perPage, total int) (int, int) {
+	start := (page - 1) * perPage
+	if start > total {
+		start = total
+	}
+
+	end := start + perPage
+	if end > total {
+		end = total
+	}
+
+	return start, end
+}
+
+func totalPages(total, perPage int) int {
+	pages := (total + perPage - 1) / perPage
+	if pages == 0 {
+		return 1
+	}
+
+	return pages
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, messages ...string) {
+	writeJSON(w, status, map[string][]string{"errors": messages})
+}
@@ -0,0 +1,185 @@
+package unsplashtest_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/kazhuravlev/go-unsplash/unsplash"
+	"github.com/kazhuravlev/go-unsplash/unsplash/unsplashtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"testing"
+)
+
+func newServer(t *testing.T) (*unsplashtest.Server, *unsplash.Client) {
+	srv := unsplashtest.NewServer()
+
+	author := unsplash.User{ID: "u1", Username: "jdoe", Name: "John Doe"}
+	for i := 0; i < 25; i++ {
+		photo := unsplash.Photo{ID: fmt.Sprintf("p%d", i), User: author, Description: "red car"}
+		if i%2 == 1 {
+			photo.Description = "blue sky"
+		}
+		srv.AddPhotos(photo)
+	}
+
+	srv.AddCollections(unsplash.Collection{ID: "7", Title: "Cars", User: author})
+	srv.AddCollectionPhotos("7", "p0", "p2")
+
+	c, err := srv.Client()
+	require.Nil(t, err)
+
+	return srv, c
+}
+
+func TestServer_Photos(t *testing.T) {
+	srv, c := newServer(t)
+	defer srv.Close()
+
+	ctx := context.Background()
+
+	photos, rl, err := c.GetPhotos(ctx, unsplash.GetPhotosOptions{Page: 3})
+	require.Nil(t, err)
+	assert.Len(t, photos, 5)
+	assert.Equal(t, 4999, rl.Remaining)
+
+	photo, _, err := c.GetPhoto(ctx, "p3")
+	require.Nil(t, err)
+	assert.Equal(t, "jdoe", photo.User.Username)
+
+	_, _, err = c.GetPhoto(ctx, "unknown")
+	assert.True(t, errors.Is(err, unsplash.ErrNotFound))
+
+	photo, _, err = c.LikePhoto(ctx, "p3")
+	require.Nil(t, err)
+	assert.True(t, photo.LikedByUser)
+
+	photo, _, err = c.UpdatePhoto(ctx, unsplash.UpdatePhotoOptions{ID: "p3", Location: unsplash.UpdateLocation{Name: "example"}})
+	require.Nil(t, err)
+	assert.Equal(t, "example", photo.Location.Name)
+
+	stored, ok := srv.Photo("p3")
+	require.True(t, ok)
+	assert.Equal(t, "example", stored.Location.Name)
+
+	it := c.GetPhotosIterator(unsplash.GetPhotosOptions{PerPage: 10}, 0)
+	var n int
+	for it.Next(ctx) {
+		n++
+	}
+	require.Nil(t, it.Err())
+	assert.Equal(t, 25, n)
+}
+
+func TestServer_Search(t *testing.T) {
+	srv, c := newServer(t)
+	defer srv.Close()
+
+	ctx := context.Background()
+
+	res, _, err := c.SearchPhotos(ctx, unsplash.SearchPhotosOptions{Query: "car", PerPage: 5})
+	require.Nil(t, err)
+	assert.Equal(t, 13, res.Total)
+	assert.Equal(t, 3, res.TotalPages)
+	assert.Len(t, res.Results, 5)
+
+	collections, _, err := c.SearchCollections(ctx, unsplash.SearchCollectionsOptions{Query: "cars"})
+	require.Nil(t, err)
+	assert.Equal(t, 1, collections.Total)
+
+	users, _, err := c.SearchUsers(ctx, unsplash.SearchUsersOptions{Query: "john"})
+	require.Nil(t, err)
+	require.Len(t, users.Results, 1)
+	assert.Equal(t, "jdoe", users.Results[0].Username)
+}
+
+func TestServer_UsersAndCollections(t *testing.T) {
+	srv, c := newServer(t)
+	defer srv.Close()
+
+	ctx := context.Background()
+
+	user, _, err := c.GetUser(ctx, "jdoe")
+	require.Nil(t, err)
+	assert.Equal(t, "John Doe", user.Name)
+
+	photos, _, err := c.ListUserPhotos(ctx, unsplash.ListUserPhotosOptions{Username: "jdoe", PerPage: 30})
+	require.Nil(t, err)
+	assert.Len(t, photos, 25)
+
+	photos, _, err = c.ListCollectionPhotos(ctx, unsplash.ListCollectionPhotosOptions{ID: "7"})
+	require.Nil(t, err)
+	assert.Len(t, photos, 2)
+
+	collection, _, err := c.CreateCollection(ctx, unsplash.CreateCollectionOptions{Title: "Sky"})
+	require.Nil(t, err)
+	assert.Equal(t, "1", collection.ID)
+
+	_, _, err = c.AddPhotoToCollection(ctx, unsplash.CollectionPhotoOptions{CollectionID: collection.ID, PhotoID: "p1"})
+	require.Nil(t, err)
+
+	stored, ok := srv.Collection(collection.ID)
+	require.True(t, ok)
+	assert.Equal(t, 1, stored.TotalPhotos)
+
+	_, err = c.DeleteCollection(ctx, collection.ID)
+	require.Nil(t, err)
+
+	_, _, err = c.GetCollection(ctx, collection.ID)
+	assert.True(t, errors.Is(err, unsplash.ErrNotFound))
+}
+
+func TestServer_InjectError(t *testing.T) {
+	srv, c := newServer(t)
+	defer srv.Close()
+
+	srv.InjectError(unsplashtest.Error{
+		Method:   http.MethodGet,
+		Path:     "/photos/p1",
+		Status:   http.StatusServiceUnavailable,
+		Messages: []string{"maintenance"},
+		Times:    1,
+	})
+
+	_, _, err := c.GetPhoto(context.Background(), "p1")
+	assert.True(t, errors.Is(err, unsplash.ErrServer))
+
+	var apiErr *unsplash.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, []string{"maintenance"}, apiErr.Messages)
+
+	_, _, err = c.GetPhoto(context.Background(), "p1")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, srv.Requests())
+}
+
+func TestServer_RateLimit(t *testing.T) {
+	srv, c := newServer(t)
+	defer srv.Close()
+
+	srv.SetRateLimit(50, 1)
+
+	_, rl, err := c.GetPhoto(context.Background(), "p1")
+	require.Nil(t, err)
+	assert.Equal(t, 0, rl.Remaining)
+
+	_, _, err = c.GetPhoto(context.Background(), "p1")
+	assert.True(t, errors.Is(err, unsplash.ErrRateLimited))
+}
+
+func TestServer_RandomPhotos(t *testing.T) {
+	srv, c := newServer(t)
+	defer srv.Close()
+
+	photos, _, err := c.GetRandomPhotos(context.Background(), unsplash.GetRandomPhotosOptions{Count: 3})
+	require.Nil(t, err)
+	assert.Len(t, photos, 3)
+
+	for _, count := range []string{"-1", "0", "31", "many"} {
+		resp, err := http.Get(srv.URL + "/photos/random?count=" + count)
+		require.Nil(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode, count)
+	}
+}
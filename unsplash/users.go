@@ -0,0 +1,336 @@
+package unsplash
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+func (c *Client) GetUser(ctx context.Context, username string) (*User, *RateLimit, error) {
+	if username == "" {
+		return nil, nil, ErrBadRequest
+	}
+
+	var user User
+	rl, err := c.do(ctx, http.MethodGet, fmt.Sprintf("/users/%s", username), nil, nil, http.StatusOK, &user)
+	if err != nil {
+		return nil, rl, err
+	}
+
+	return &user, rl, nil
+}
+
+func (c *Client) GetUserPortfolio(ctx context.Context, username string) (*UserPortfolio, *RateLimit, error) {
+	if username == "" {
+		return nil, nil, ErrBadRequest
+	}
+
+	var portfolio UserPortfolio
+	rl, err := c.do(ctx, http.MethodGet, fmt.Sprintf("/users/%s/portfolio", username), nil, nil, http.StatusOK, &portfolio)
+	if err != nil {
+		return nil, rl, err
+	}
+
+	return &portfolio, rl, nil
+}
+
+type ListUserPhotosOptions struct {
+	// Username The user’s username.
+	Username string
+	// Page Page number to retrieve. (Optional; default: 1)
+	Page int
+	// PerPage Number of items per page. (Optional; default: 10)
+	PerPage int
+	// OrderBy How to sort the photos. (Optional; default: latest)
+	OrderBy OrderBy
+	// Stats Show the stats for each user’s photo. (Optional; default: false)
+	Stats bool
+	// Resolution The frequency of the stats. (Optional; default: days)
+	Resolution Resolution
+	// Quantity The amount of for each stat. (Optional; default: 30)
+	Quantity int
+	// Orientation Filter by photo orientation. Valid values are landscape, portrait, and squarish.
+	Orientation Orientation
+}
+
+func (o ListUserPhotosOptions) validate() error {
+	if o.Username == "" {
+		return ErrBadRequest
+	}
+
+	if o.Page < 0 {
+		return ErrBadRequest
+	}
+
+	if o.PerPage < 0 {
+		return ErrBadRequest
+	}
+
+	if o.PerPage > maxListItems {
+		return ErrBadRequest
+	}
+
+	if o.Quantity < 0 {
+		return ErrBadRequest
+	}
+
+	if o.Quantity > maxListItems {
+		return ErrBadRequest
+	}
+
+	switch o.Orientation {
+	case "", OrientationLandscape, OrientationPortrait, OrientationSquarish:
+	default:
+		return ErrBadRequest
+	}
+
+	return nil
+}
+
+func (o ListUserPhotosOptions) query() url.Values {
+	query := url.Values{}
+	if o.Page == 0 {
+		o.Page = 1
+	}
+
+	if o.PerPage == 0 {
+		o.PerPage = 10
+	}
+
+	if o.OrderBy == "" {
+		o.OrderBy = OrderByLatest
+	}
+
+	if o.Stats {
+		if o.Resolution == "" {
+			o.Resolution = ResolutionDays
+		}
+
+		if o.Quantity == 0 {
+			o.Quantity = maxListItems
+		}
+
+		query.Set("stats", "true")
+		query.Set("resolution", string(o.Resolution))
+		query.Set("quantity", strconv.Itoa(o.Quantity))
+	}
+
+	if o.Orientation != "" {
+		query.Set("orientation", string(o.Orientation))
+	}
+
+	query.Set("page", strconv.Itoa(o.Page))
+	query.Set("per_page", strconv.Itoa(o.PerPage))
+	query.Set("order_by", string(o.OrderBy))
+
+	return query
+}
+
+func (c *Client) ListUserPhotos(ctx context.Context, opts ListUserPhotosOptions) ([]Photo, *RateLimit, error) {
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	var photos []Photo
+	rl, err := c.do(ctx, http.MethodGet, fmt.Sprintf("/users/%s/photos", opts.Username), opts.query(), nil, http.StatusOK, &photos)
+	if err != nil {
+		return nil, rl, err
+	}
+
+	return photos, rl, nil
+}
+
+type ListUserLikesOptions struct {
+	// Username The user’s username.
+	Username string
+	// Page Page number to retrieve. (Optional; default: 1)
+	Page int
+	// PerPage Number of items per page. (Optional; default: 10)
+	PerPage int
+	// OrderBy How to sort the photos. (Optional; default: latest)
+	OrderBy OrderBy
+	// Orientation Filter by photo orientation. Valid values are landscape, portrait, and squarish.
+	Orientation Orientation
+}
+
+func (o ListUserLikesOptions) validate() error {
+	if o.Username == "" {
+		return ErrBadRequest
+	}
+
+	if o.Page < 0 {
+		return ErrBadRequest
+	}
+
+	if o.PerPage < 0 {
+		return ErrBadRequest
+	}
+
+	if o.PerPage > maxListItems {
+		return ErrBadRequest
+	}
+
+	switch o.Orientation {
+	case "", OrientationLandscape, OrientationPortrait, OrientationSquarish:
+	default:
+		return ErrBadRequest
+	}
+
+	return nil
+}
+
+func (o ListUserLikesOptions) query() url.Values {
+	query := url.Values{}
+	if o.Page == 0 {
+		o.Page = 1
+	}
+
+	if o.PerPage == 0 {
+		o.PerPage = 10
+	}
+
+	if o.OrderBy == "" {
+		o.OrderBy = OrderByLatest
+	}
+
+	if o.Orientation != "" {
+		query.Set("orientation", string(o.Orientation))
+	}
+
+	query.Set("page", strconv.Itoa(o.Page))
+	query.Set("per_page", strconv.Itoa(o.PerPage))
+	query.Set("order_by", string(o.OrderBy))
+
+	return query
+}
+
+func (c *Client) ListUserLikes(ctx context.Context, opts ListUserLikesOptions) ([]Photo, *RateLimit, error) {
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	var photos []Photo
+	rl, err := c.do(ctx, http.MethodGet, fmt.Sprintf("/users/%s/likes", opts.Username), opts.query(), nil, http.StatusOK, &photos)
+	if err != nil {
+		return nil, rl, err
+	}
+
+	return photos, rl, nil
+}
+
+type ListUserCollectionsOptions struct {
+	// Username The user’s username.
+	Username string
+	// Page Page number to retrieve. (Optional; default: 1)
+	Page int
+	// PerPage Number of items per page. (Optional; default: 10)
+	PerPage int
+}
+
+func (o ListUserCollectionsOptions) validate() error {
+	if o.Username == "" {
+		return ErrBadRequest
+	}
+
+	if o.Page < 0 {
+		return ErrBadRequest
+	}
+
+	if o.PerPage < 0 {
+		return ErrBadRequest
+	}
+
+	if o.PerPage > maxListItems {
+		return ErrBadRequest
+	}
+
+	return nil
+}
+
+func (o ListUserCollectionsOptions) query() url.Values {
+	query := url.Values{}
+	if o.Page == 0 {
+		o.Page = 1
+	}
+
+	if o.PerPage == 0 {
+		o.PerPage = 10
+	}
+
+	query.Set("page", strconv.Itoa(o.Page))
+	query.Set("per_page", strconv.Itoa(o.PerPage))
+
+	return query
+}
+
+func (c *Client) ListUserCollections(ctx context.Context, opts ListUserCollectionsOptions) ([]Collection, *RateLimit, error) {
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	var collections []Collection
+	rl, err := c.do(ctx, http.MethodGet, fmt.Sprintf("/users/%s/collections", opts.Username), opts.query(), nil, http.StatusOK, &collections)
+	if err != nil {
+		return nil, rl, err
+	}
+
+	return collections, rl, nil
+}
+
+type GetUserStatisticsOptions struct {
+	// Username The user’s username.
+	Username string
+	// Resolution The frequency of the stats
+	Resolution Resolution
+	// Quantity The amount of for each stat
+	Quantity int
+}
+
+func (o GetUserStatisticsOptions) validate() error {
+	if o.Username == "" {
+		return ErrBadRequest
+	}
+
+	if o.Quantity < 0 {
+		return ErrBadRequest
+	}
+
+	if o.Quantity > maxListItems {
+		return ErrBadRequest
+	}
+
+	return nil
+}
+
+func (o GetUserStatisticsOptions) query() url.Values {
+	query := url.Values{}
+
+	if o.Resolution == "" {
+		o.Resolution = ResolutionDays
+	}
+
+	if o.Quantity == 0 {
+		o.Quantity = 1
+	}
+
+	query.Set("resolution", string(o.Resolution))
+	query.Set("quantity", strconv.Itoa(o.Quantity))
+
+	return query
+}
+
+func (c *Client) GetUserStatistics(ctx context.Context, opts GetUserStatisticsOptions) (*UserStatistics, *RateLimit, error) {
+	if err := opts.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	var stat UserStatistics
+	rl, err := c.do(ctx, http.MethodGet, fmt.Sprintf("/users/%s/statistics", opts.Username), opts.query(), nil, http.StatusOK, &stat)
+	if err != nil {
+		return nil, rl, err
+	}
+
+	return &stat, rl, nil
+}
@@ -0,0 +1,105 @@
+package unsplash_test
+
+import (
+	"context"
+	"github.com/kazhuravlev/go-unsplash/unsplash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"testing"
+)
+
+func TestGetUser(t *testing.T) {
+	c, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/users/jdoe", r.URL.Path)
+		w.Write([]byte(`{"id":"1","username":"jdoe","total_photos":3}`))
+	})
+	defer done()
+
+	user, rl, err := c.GetUser(context.Background(), "jdoe")
+	require.Nil(t, err)
+	assert.NotNil(t, rl)
+	assert.Equal(t, "jdoe", user.Username)
+	assert.Equal(t, 3, user.TotalPhotos)
+
+	_, _, err = c.GetUser(context.Background(), "")
+	assert.Equal(t, unsplash.ErrBadRequest, err)
+}
+
+func TestGetUserPortfolio(t *testing.T) {
+	c, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/users/jdoe/portfolio", r.URL.Path)
+		w.Write([]byte(`{"url":"https://example.com"}`))
+	})
+	defer done()
+
+	portfolio, _, err := c.GetUserPortfolio(context.Background(), "jdoe")
+	require.Nil(t, err)
+	assert.Equal(t, "https://example.com", portfolio.URL)
+}
+
+func TestListUserPhotos(t *testing.T) {
+	c, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/users/jdoe/photos", r.URL.Path)
+		q := r.URL.Query()
+		assert.Equal(t, "2", q.Get("page"))
+		assert.Equal(t, "latest", q.Get("order_by"))
+		assert.Equal(t, "true", q.Get("stats"))
+		assert.Equal(t, "portrait", q.Get("orientation"))
+		w.Write([]byte(`[{"id":"a","statistics":{"downloads":{"total":5}}},{"id":"b"}]`))
+	})
+	defer done()
+
+	photos, _, err := c.ListUserPhotos(context.Background(), unsplash.ListUserPhotosOptions{
+		Username:    "jdoe",
+		Page:        2,
+		Stats:       true,
+		Orientation: unsplash.OrientationPortrait,
+	})
+	require.Nil(t, err)
+	require.Len(t, photos, 2)
+	require.NotNil(t, photos[0].Statistics)
+	assert.Equal(t, 5, photos[0].Statistics.Downloads.Total)
+
+	_, _, err = c.ListUserPhotos(context.Background(), unsplash.ListUserPhotosOptions{Username: "jdoe", PerPage: 31})
+	assert.Equal(t, unsplash.ErrBadRequest, err)
+}
+
+func TestListUserLikes(t *testing.T) {
+	c, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/users/jdoe/likes", r.URL.Path)
+		w.Write([]byte(`[{"id":"a"}]`))
+	})
+	defer done()
+
+	photos, _, err := c.ListUserLikes(context.Background(), unsplash.ListUserLikesOptions{Username: "jdoe"})
+	require.Nil(t, err)
+	assert.Len(t, photos, 1)
+}
+
+func TestListUserCollections(t *testing.T) {
+	c, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/users/jdoe/collections", r.URL.Path)
+		w.Write([]byte(`[{"id":"1","title":"cars"}]`))
+	})
+	defer done()
+
+	collections, _, err := c.ListUserCollections(context.Background(), unsplash.ListUserCollectionsOptions{Username: "jdoe"})
+	require.Nil(t, err)
+	require.Len(t, collections, 1)
+	assert.Equal(t, "cars", collections[0].Title)
+}
+
+func TestGetUserStatistics(t *testing.T) {
+	c, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/users/jdoe/statistics", r.URL.Path)
+		assert.Equal(t, "days", r.URL.Query().Get("resolution"))
+		w.Write([]byte(`{"username":"jdoe","views":{"total":10}}`))
+	})
+	defer done()
+
+	stat, _, err := c.GetUserStatistics(context.Background(), unsplash.GetUserStatisticsOptions{Username: "jdoe"})
+	require.Nil(t, err)
+	assert.Equal(t, "jdoe", stat.Username)
+	assert.Equal(t, 10, stat.Views.Total)
+}